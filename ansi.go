@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ansiRE matches ANSI CSI escape sequences. The submatches are the
+// parameters and final byte; a final byte of 'm' is an SGR (color) sequence.
+var ansiRE = regexp.MustCompile(`\x1b\[([0-9;?]*)([A-Za-z])`)
+
+// ansiColors are the CSS colors of the 8 standard and, following them, the 8
+// bright ANSI colors.
+var ansiColors = []string{
+	"#000000", "#cd0000", "#00cd00", "#cdcd00", "#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+	"#7f7f7f", "#ff0000", "#00ff00", "#ffff00", "#5c5cff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// sgrState is the text style accumulated from SGR sequences.
+type sgrState struct {
+	fg, bg                  string
+	bold, italic, underline bool
+}
+
+func (s *sgrState) apply(params string) {
+	if params == "" {
+		params = "0"
+	}
+	var codes []int
+	for _, p := range strings.Split(params, ";") {
+		// An empty or invalid parameter is taken as 0, keeping the
+		// positions of the parameters of extended colors.
+		code, _ := strconv.Atoi(p)
+		codes = append(codes, code)
+	}
+	for i := 0; i < len(codes); i++ {
+		switch code := codes[i]; {
+		case code == 0:
+			*s = sgrState{}
+		case code == 1:
+			s.bold = true
+		case code == 3:
+			s.italic = true
+		case code == 4:
+			s.underline = true
+		case code == 22:
+			s.bold = false
+		case code == 23:
+			s.italic = false
+		case code == 24:
+			s.underline = false
+		case code >= 30 && code <= 37:
+			s.fg = ansiColors[code-30]
+		case code == 38:
+			color, n := extendedColor(codes[i+1:])
+			if color != "" {
+				s.fg = color
+			}
+			i += n
+		case code == 39:
+			s.fg = ""
+		case code >= 40 && code <= 47:
+			s.bg = ansiColors[code-40]
+		case code == 48:
+			color, n := extendedColor(codes[i+1:])
+			if color != "" {
+				s.bg = color
+			}
+			i += n
+		case code == 49:
+			s.bg = ""
+		case code >= 90 && code <= 97:
+			s.fg = ansiColors[code-90+8]
+		case code >= 100 && code <= 107:
+			s.bg = ansiColors[code-100+8]
+		}
+	}
+}
+
+// extendedColor parses the parameters following a 38 or 48 SGR code: 5;N for
+// color N of the 256 color palette or 2;R;G;B for a 24-bit color. It returns
+// the CSS color, or "" if the parameters are invalid, and the number of
+// parameters consumed.
+func extendedColor(params []int) (string, int) {
+	if len(params) == 0 {
+		return "", 0
+	}
+	switch params[0] {
+	case 5:
+		if len(params) < 2 {
+			return "", len(params)
+		}
+		return paletteColor(params[1]), 2
+	case 2:
+		if len(params) < 4 {
+			return "", len(params)
+		}
+		r, g, b := params[1], params[2], params[3]
+		if r > 255 || g > 255 || b > 255 {
+			return "", 4
+		}
+		return fmt.Sprintf("#%02x%02x%02x", r, g, b), 4
+	}
+	return "", 1
+}
+
+// paletteColor returns the CSS color of color n of the 256 color palette:
+// the 16 ansiColors, a 6x6x6 color cube and 24 shades of gray.
+func paletteColor(n int) string {
+	switch {
+	case n < 0 || n > 255:
+		return ""
+	case n < 16:
+		return ansiColors[n]
+	case n < 232:
+		n -= 16
+		level := func(i int) int {
+			if i == 0 {
+				return 0
+			}
+			return 55 + 40*i
+		}
+		return fmt.Sprintf("#%02x%02x%02x", level(n/36), level(n/6%6), level(n%6))
+	}
+	gray := 8 + 10*(n-232)
+	return fmt.Sprintf("#%02x%02x%02x", gray, gray, gray)
+}
+
+func (s sgrState) style() string {
+	var style []string
+	if s.fg != "" {
+		style = append(style, "color:"+s.fg)
+	}
+	if s.bg != "" {
+		style = append(style, "background-color:"+s.bg)
+	}
+	if s.bold {
+		style = append(style, "font-weight:bold")
+	}
+	if s.italic {
+		style = append(style, "font-style:italic")
+	}
+	if s.underline {
+		style = append(style, "text-decoration:underline")
+	}
+	return strings.Join(style, ";")
+}
+
+// stripANSI removes ANSI escape sequences from s.
+func stripANSI(s string) string {
+	return ansiRE.ReplaceAllString(s, "")
+}
+
+// ansiToHTML escapes s for HTML, rendering its SGR sequences as styled spans
+// and dropping any other escape sequences.
+func ansiToHTML(s string) template.HTML {
+	var b strings.Builder
+	var state sgrState
+	open := false
+	last := 0
+	for _, m := range ansiRE.FindAllStringSubmatchIndex(s, -1) {
+		b.WriteString(template.HTMLEscapeString(s[last:m[0]]))
+		last = m[1]
+		if s[m[4]:m[5]] != "m" {
+			continue
+		}
+		state.apply(s[m[2]:m[3]])
+		if open {
+			b.WriteString("</span>")
+			open = false
+		}
+		if style := state.style(); style != "" {
+			b.WriteString(`<span style="` + style + `">`)
+			open = true
+		}
+	}
+	b.WriteString(template.HTMLEscapeString(s[last:]))
+	if open {
+		b.WriteString("</span>")
+	}
+	return template.HTML(b.String())
+}
+
+// logOutput returns the log output for rendering by log.html: rendered in
+// color if the request has color=true, and with escape sequences stripped
+// otherwise.
+func logOutput(req *http.Request, s string) interface{} {
+	if req.FormValue("color") == "true" {
+		return ansiToHTML(s)
+	}
+	return stripANSI(s)
+}
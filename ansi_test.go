@@ -0,0 +1,26 @@
+package main
+
+import "testing"
+
+func TestANSIToHTMLExtendedColors(t *testing.T) {
+	testCases := []struct {
+		in, out string
+	}{
+		{"\x1b[31mred\x1b[0m", `<span style="color:#cd0000">red</span>`},
+		{"\x1b[38;5;196mred", `<span style="color:#ff0000">red</span>`},
+		// The 4 is a palette index, not underline.
+		{"\x1b[38;5;4mblue", `<span style="color:#0000ee">blue</span>`},
+		{"\x1b[48;5;232mgray", `<span style="background-color:#080808">gray</span>`},
+		{"\x1b[38;5;16;48;5;231mcube", `<span style="color:#000000;background-color:#ffffff">cube</span>`},
+		// The 1;2;3 are the color, not bold and italic.
+		{"\x1b[38;2;1;2;3mrgb", `<span style="color:#010203">rgb</span>`},
+		{"\x1b[48;2;255;128;0;1mrgb", `<span style="background-color:#ff8000;font-weight:bold">rgb</span>`},
+		{"\x1b[38;2;300;0;0mbad", `bad`},
+		{"\x1b[38;5mtruncated", `truncated`},
+	}
+	for _, tc := range testCases {
+		if got := string(ansiToHTML(tc.in)); got != tc.out {
+			t.Errorf("%q: expected %s, got %s", tc.in, tc.out, got)
+		}
+	}
+}
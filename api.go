@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// The node lifecycle REST API. Requests and responses are JSON and errors are
+// reported as plain text with the following status codes:
+//
+//   400 Bad Request          malformed request body or unknown action
+//   404 Not Found            no such node
+//   405 Method Not Allowed   wrong method for the resource
+//   409 Conflict             the action conflicts with the cluster's state,
+//                            e.g. adding a node while the bootstrap node is
+//                            down or once -max-port is reached
+//   428 Precondition Required
+//                            removing a node must be confirmed: the body
+//                            is JSON holding a one-time token in "confirm"
+//                            to repeat the request with as ?confirm=<token>
+//   500 Internal Server Error
+//                            a node couldn't be created or started, in
+//                            which case it isn't added
+//
+// Successful requests return 200 with the node's status, except adding a
+// node which returns 201 and removing one which returns 204.
+
+func makeAPINode(t *node) apiNode {
+	return apiNode{Name: t.Name, Status: t.Status(), Healthy: t.Healthy(), URL: t.URL, Build: t.Build()}
+}
+
+// apiAddNodeRequest is the optional body of POST /api/nodes.
+type apiAddNodeRequest struct {
+	Store string `json:"store"`
+}
+
+// apiAddNode handles POST /api/nodes, adding and starting a node.
+func (c *cluster) apiAddNode(rw http.ResponseWriter, req *http.Request) {
+	var r apiAddNodeRequest
+	if err := json.NewDecoder(req.Body).Decode(&r); err != nil && err != io.EOF {
+		http.Error(rw, fmt.Sprintf("invalid request: %s", err), http.StatusBadRequest)
+		return
+	}
+	if c.BootstrapDown() {
+		http.Error(rw, fmt.Sprintf("node %s, which new nodes join through, is %s",
+			bootstrapNode, c.Nodes[bootstrapNode].Status()), http.StatusConflict)
+		return
+	}
+	if err := c.portsAvailable(1); err != nil {
+		http.Error(rw, err.Error(), http.StatusConflict)
+		return
+	}
+	if r.Store != "" {
+		c.stores[c.nextNodeID()] = r.Store
+	}
+	c.recorder.record("add", "", 1)
+	t, err := c.newNode()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.WriteHeader(http.StatusCreated)
+	writeJSON(rw, makeAPINode(t))
+}
+
+// apiNodeResource handles GET and DELETE /api/nodes/<node>.
+func (c *cluster) apiNodeResource(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t, ok := c.Nodes[args["node"]]
+	if !ok {
+		http.Error(rw, fmt.Sprintf("node %s not found", args["node"]), http.StatusNotFound)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		writeJSON(rw, makeAPINode(t))
+	case http.MethodDelete:
+		if !c.apiConfirmed(rw, req, fmt.Sprintf("removing node %s", t.Name)) {
+			return
+		}
+		if err := c.removeNode(t); err != nil {
+			http.Error(rw, err.Error(), http.StatusConflict)
+			return
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(rw, "use GET or DELETE", http.StatusMethodNotAllowed)
+	}
+}
+
+// apiNodeAction handles POST /api/nodes/<node>/<action>.
+func (c *cluster) apiNodeAction(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t, ok := c.Nodes[args["node"]]
+	if !ok {
+		http.Error(rw, fmt.Sprintf("node %s not found", args["node"]), http.StatusNotFound)
+		return
+	}
+	if req.Method != http.MethodPost {
+		http.Error(rw, fmt.Sprintf("%s requires POST", args["action"]), http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch args["action"] {
+	case "start", "stop", "pause", "resume", "restart":
+	default:
+		http.Error(rw, fmt.Sprintf("unknown action %q", args["action"]), http.StatusBadRequest)
+		return
+	}
+	if err := c.nodeAction(t, args["action"]); err != nil {
+		http.Error(rw, err.Error(), http.StatusConflict)
+		return
+	}
+	writeJSON(rw, makeAPINode(t))
+}
+
+// removeNode stops the node and removes it from the cluster. Its data
+// directory is left in place. The bootstrap node can only be removed once it
+// is the last node.
+func (c *cluster) removeNode(t *node) error {
+	if t.Name == bootstrapNode && len(c.Nodes) > 1 {
+		return fmt.Errorf("node %s is the bootstrap node and other nodes still exist", t.Name)
+	}
+	c.recorder.record("remove", t.Name, 0)
+	t.stopService()
+	t.unblockAll()
+	delete(c.Nodes, t.Name)
+	if id, err := strconv.Atoi(t.Name); err == nil {
+		delete(c.stores, id)
+	}
+	c.events.add(t.Name, "removed")
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIRemoveNodeConfirmation(t *testing.T) {
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	c.Nodes["2"] = newNode("2", []string{"/bin/true"}, nil, false, "", "", "", "")
+	c.stores = perNodeAttribute{2: "type=mem,size=1GiB"}
+	remove := func(url string) *httptest.ResponseRecorder {
+		rw := httptest.NewRecorder()
+		c.apiNodeResource(rw, httptest.NewRequest("DELETE", url, nil), map[string]string{"node": "2"})
+		return rw
+	}
+
+	rw := remove("/api/nodes/2")
+	if rw.Code != http.StatusPreconditionRequired {
+		t.Fatalf("expected a confirmation to be required, got %d: %s", rw.Code, rw.Body)
+	}
+	if _, ok := c.Nodes["2"]; !ok {
+		t.Fatalf("expected the unconfirmed removal not to remove the node")
+	}
+	var resp struct{ Confirm string }
+	if err := json.Unmarshal(rw.Body.Bytes(), &resp); err != nil || resp.Confirm == "" {
+		t.Fatalf("expected a confirmation token: %s, %v", rw.Body, err)
+	}
+
+	// A token is bound to the path it was issued for.
+	if rw := remove("/api/nodes/3?confirm=" + resp.Confirm); rw.Code != http.StatusPreconditionRequired {
+		t.Fatalf("expected a token for another path to be rejected, got %d", rw.Code)
+	}
+	rw = remove("/api/nodes/2")
+	if err := json.Unmarshal(rw.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if rw := remove("/api/nodes/2?confirm=" + resp.Confirm); rw.Code != http.StatusNoContent {
+		t.Fatalf("expected the confirmed removal to succeed, got %d: %s", rw.Code, rw.Body)
+	}
+	if _, ok := c.Nodes["2"]; ok {
+		t.Fatalf("expected the node to be removed")
+	}
+	if _, ok := c.stores[2]; ok {
+		t.Fatalf("expected the store of the removed node to be forgotten")
+	}
+}
+
+func TestAPIName(t *testing.T) {
+	defer func(name string) { *demoName = name }(*demoName)
+	*demoName = "staging"
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+
+	for _, tc := range []struct {
+		path string
+		fn   routeFn
+	}{
+		{"/api/nodes", c.apiNodes},
+		{"/api/quorum", c.apiQuorum},
+		{"/api/cluster", c.apiCluster},
+	} {
+		rw := httptest.NewRecorder()
+		tc.fn(rw, httptest.NewRequest("GET", tc.path, nil), nil)
+		if got := rw.Header().Get(nameHeader); got != "staging" {
+			t.Errorf("%s: expected the name header to be staging, got %q", tc.path, got)
+		}
+		if tc.path == "/api/nodes" {
+			continue
+		}
+		var v struct{ Name string }
+		if err := json.Unmarshal(rw.Body.Bytes(), &v); err != nil {
+			t.Fatal(err)
+		}
+		if v.Name != "staging" {
+			t.Errorf("%s: expected the name staging, got %q", tc.path, v.Name)
+		}
+	}
+}
+
+func TestAPIUnknownAction(t *testing.T) {
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	c.Nodes["1"] = newNode("1", []string{"/bin/true"}, nil, false, "", "", "", "")
+	rw := httptest.NewRecorder()
+	c.apiNodeAction(rw, httptest.NewRequest("POST", "/api/nodes/1/explode", nil),
+		map[string]string{"node": "1", "action": "explode"})
+	if rw.Code != http.StatusBadRequest {
+		t.Fatalf("expected an unknown action to be a bad request, got %d: %s", rw.Code, rw.Body)
+	}
+}
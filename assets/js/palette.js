@@ -0,0 +1,75 @@
+// A minimal command palette, opened with "/" or Cmd-K/Ctrl-K, mapping
+// commands to the roachdemo routes. basePath is set by the layout.
+$(function() {
+  var commands = [
+    {name: "add node", post: basePath + "/add"},
+    {name: "start all", post: basePath + "/startall"},
+    {name: "stop all", post: basePath + "/stopall"},
+    {name: "pause all", post: basePath + "/pauseall"},
+    {name: "resume all", post: basePath + "/resumeall"},
+    {name: "go to cluster", href: basePath + "/"},
+    {name: "go to events", href: basePath + "/events"},
+    {name: "go to self check", href: basePath + "/selfcheck"}
+  ];
+
+  $.getJSON(basePath + "/api/nodes", function(nodes) {
+    $.each(nodes, function(i, node) {
+      commands.push({name: "go to node " + node.name, href: basePath + "/node/" + node.name});
+      commands.push({name: "start node " + node.name, post: basePath + "/node/" + node.name + "/start"});
+      commands.push({name: "stop node " + node.name, post: basePath + "/node/" + node.name + "/stop"});
+    });
+  });
+
+  var palette = $('<div id="palette" class="panel panel-default">' +
+    '<div class="panel-body"><input type="text" class="form-control" placeholder="command">' +
+    '<ul class="list-unstyled"></ul></div></div>').hide().appendTo("body");
+  var input = palette.find("input");
+  var list = palette.find("ul");
+
+  function matches() {
+    var q = input.val().toLowerCase();
+    return $.grep(commands, function(c) { return c.name.indexOf(q) >= 0; });
+  }
+
+  function update() {
+    list.empty();
+    $.each(matches().slice(0, 10), function(i, c) {
+      $("<li>").text(c.name).toggleClass("text-primary", i == 0).appendTo(list);
+    });
+  }
+
+  function run(c) {
+    if (c.href) {
+      window.location.href = c.href;
+      return;
+    }
+    $('<form method="post">').attr("action", c.post).appendTo("body").submit();
+  }
+
+  function open() {
+    input.val("");
+    update();
+    palette.show();
+    input.focus();
+  }
+
+  $(document).keydown(function(e) {
+    var typing = $(e.target).is("input, textarea");
+    if ((e.key == "k" && (e.metaKey || e.ctrlKey)) || (e.key == "/" && !typing)) {
+      e.preventDefault();
+      open();
+    }
+  });
+
+  input.on("input", update);
+  input.keydown(function(e) {
+    if (e.key == "Escape") {
+      palette.hide();
+    } else if (e.key == "Enter") {
+      var m = matches();
+      if (m.length > 0) {
+        run(m[0]);
+      }
+    }
+  });
+});
@@ -1,13 +1,22 @@
 // Code generated by go-bindata.
 // sources:
 // assets/css/default.css
+// assets/favicon.ico
+// assets/js/palette.js
 // assets/templates/cluster.html
+// assets/templates/confirm.html
 // assets/templates/error.html
+// assets/templates/events.html
+// assets/templates/follow.html
 // assets/templates/layout.html
+// assets/templates/leases.html
 // assets/templates/log.html
 // assets/templates/node.html
 // assets/templates/notfound.html
+// assets/templates/ports.html
 // assets/templates/run.html
+// assets/templates/selfcheck.html
+// assets/templates/settings.html
 // DO NOT EDIT!
 
 package main
@@ -75,7 +84,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsCssDefaultCss = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4a\xca\x4f\xa9\x54\xa8\xe6\xe2\x2c\x48\x4c\x49\xc9\xcc\x4b\xd7\x2d\xc9\x2f\xb0\x52\x30\x37\x28\xa8\xb0\xe6\xaa\xe5\xca\x30\x02\x49\xe5\x26\x16\xa5\x67\xe6\x41\x64\x0c\xac\xe1\xfc\xa4\xfc\x92\x92\xfc\x5c\x2b\x05\x23\x88\x62\x40\x00\x00\x00\xff\xff\x28\xaf\x7a\xd0\x49\x00\x00\x00")
+var _assetsCssDefaultCss = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x44\x8f\xe1\x4a\x43\x31\x0c\x85\x7f\xdf\x3c\x45\x40\x04\x05\x2b\xb1\x4e\x1c\xed\xd3\xb4\xb4\xbb\xb7\x70\xd7\x84\x2d\xb2\xaa\xec\xdd\xa5\xbb\x65\x42\xff\x34\xe7\x3b\xc9\x39\x91\xd3\x37\xfe\xc2\x24\x21\xa5\x52\x67\xa3\x2c\x0e\x3f\x49\x9a\x87\x2b\x2c\xb6\x4b\xc7\x70\x9a\x4b\xdd\x14\xf2\xf7\x7f\x64\x55\x3e\x3a\xb4\x03\x86\x07\x09\x6b\x56\xcd\xdd\x23\x7c\x2e\x5a\xb8\x3a\x3c\x94\x96\x93\x87\xe9\x66\xdf\xdf\xd8\x69\xcd\x07\x75\xf8\x41\x8f\x1e\xa6\x4b\x49\xba\x38\xdc\xd1\x26\x8d\xe5\x1b\x61\xec\x98\xfe\x98\x52\x53\x6e\xfd\x18\xf5\x08\x91\x9b\x39\x2f\x21\xf1\xc5\x21\xe1\x4e\x1a\xbe\x59\x69\x78\x9a\x63\x78\xa2\x17\x1c\xef\xf5\xfd\xb9\xb7\xb8\xe7\xfa\x5a\xff\xeb\x38\xdc\x4b\x43\x42\x42\xf2\x70\x85\xbf\x01\x00\x3d\xc0\x1c\xb8\x07\x01\x00\x00")
 
 func assetsCssDefaultCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -90,12 +99,52 @@ func assetsCssDefaultCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/css/default.css", size: 73, mode: os.FileMode(420), modTime: time.Unix(1467317820, 0)}
+	info := bindataFileInfo{name: "assets/css/default.css", size: 263, mode: os.FileMode(420), modTime: time.Unix(1792160052, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesClusterHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x56\xdf\x6b\xeb\x36\x14\x7e\xef\x5f\x71\xa6\x5b\x48\xf2\x60\xbb\x77\x5b\xc7\x48\x9c\x40\xd9\x5e\x06\xa5\x8c\x96\xfb\x34\xc6\x50\x2c\xd9\x16\x55\x24\x4d\x3a\x6e\x13\x42\xfe\xf7\x21\x59\x89\xf3\xc3\x69\xd3\x72\x47\xa1\x8e\xa4\xef\x7c\xe7\xd3\xa7\xa3\x1f\xb9\xc3\x95\xe4\xb3\x2b\x00\x64\x50\xff\x0c\xeb\x2b\x00\x80\x05\xb5\x95\x50\x63\xb8\x99\x5c\x01\x6c\xae\xda\x51\x63\x79\x1c\x9e\xd3\xe2\xb9\xb2\xba\x51\x6c\x0c\x4a\x2b\x3e\x69\x7b\xb5\x65\xdc\x76\x3d\x6d\x5c\xcd\x29\x03\xac\x7b\x22\xbf\x94\xb7\xfe\x6f\x07\x4d\x17\x74\x59\x73\x51\xd5\xb8\x97\x4a\xbf\x70\x5b\x4a\xfd\x9a\xac\xc6\xe0\x0a\xab\xa5\x9c\x44\x85\xcb\xa4\x05\x8f\xe1\xd7\x1b\xb3\xec\x58\x94\x66\x3c\xd1\x0d\x9a\x06\x0f\x66\x93\xa0\x36\x63\xb8\xdd\x87\x22\x9d\x4b\x0e\x68\xc7\xb5\x4f\x13\xd1\x45\x63\x9d\xb6\x63\x30\x5a\x28\xe4\xb6\x43\x1b\xaa\xb8\x84\xd4\x58\x5d\x59\xee\x5c\x0f\xf9\x2f\x2d\x79\x67\xc5\x57\xb3\x04\xa7\xa5\x60\xf0\x85\x52\xda\x51\x49\x5d\x3c\x73\x16\x19\x0c\x65\x4c\xa8\x2a\x91\xbc\xf4\x93\xd9\x72\xbc\x70\x8b\xa2\xa0\x32\xa1\x52\x54\x6a\x0c\xa8\xcd\xe4\x00\x1f\x52\xee\xe0\x85\x96\x5e\xf5\x61\x9e\x42\x2b\xa4\x42\xed\xe6\xe6\x5d\x7b\x15\x0c\x6b\x6f\xda\x81\x6b\x1d\x32\xf5\x2b\x26\x54\x05\xf5\x8f\x31\x8a\x09\x67\x24\x5d\x8d\x41\x28\x29\x14\x4f\xe6\x5e\x7e\x1b\x9a\x67\xb1\x7e\x72\x57\x58\x61\xd0\x17\xd2\xf5\xb0\x6c\x54\x81\x42\xab\xe1\x28\x32\x5c\x0f\xc9\x5f\x8c\x22\x4d\x50\x57\x95\xe4\xd3\x01\x6a\x2d\x51\x98\xc1\xdf\x64\x94\xc6\xdf\xc3\xd1\x24\x62\x07\xbb\x85\x19\x8c\xd2\x42\x8a\xe2\xb9\x63\xe4\x5b\x4a\x00\x51\xc2\xf0\x7a\xc8\x53\xa4\xb6\xe2\x38\x4a\x85\x1b\x12\x4a\x46\x1d\x00\xc0\x72\x6c\xac\x9a\xc4\xf6\x26\x7e\x6b\xcb\x4b\x98\xc2\x7e\xac\xa1\x96\x2b\x74\xc3\x41\xc8\x59\x0a\xc5\x86\x04\x19\x50\x32\x4a\x29\xa2\x1d\x0e\x7c\xcc\x60\x34\xd9\x4b\x1d\x58\x7e\x98\x42\xa3\x18\x2f\x85\xe2\x6c\x3f\xf1\xab\x50\x4c\xbf\xfa\x75\xa6\x5e\x76\x1a\x53\xfa\xcf\xa1\x9a\x4d\xe0\xf4\xff\xf3\x6c\x6b\x61\xce\xc4\x0b\x14\x92\x3a\x37\x25\xbb\x75\x21\xde\xda\xbc\xd4\x76\x01\x0b\x8e\xb5\x66\x53\x62\xb4\xc3\xd0\x0d\x90\xb7\x8e\xc5\xa0\x68\x9f\xff\x9f\xb4\xa5\xc8\x59\x6c\x86\x4a\x8f\x41\x3e\xcc\x2f\xf6\x6c\x27\x3b\x47\xdb\x35\xc2\x30\x84\x72\x99\x92\xdb\x1b\xb3\x24\xb3\x07\xcd\x78\x9e\x61\x7d\x06\x44\x1b\xd4\x64\xf6\xed\xf1\xfe\x0d\xcc\xd7\x96\xe9\x5e\x57\xee\x7d\xd4\x5d\x58\xf4\x23\x60\x9e\x75\x2a\xfd\xc8\xde\x0c\x72\x9c\x6b\xb6\xea\xa0\xeb\x35\x58\xaa\x2a\x0e\xd7\xfe\x54\x80\xf1\x14\x52\x3f\x05\x07\x9b\xcd\x41\x5e\xbb\x75\x6e\xbd\xf6\x6b\x9b\xfa\xbc\x2f\x1c\x36\x9b\x83\x76\xfa\x27\x6d\x1c\x67\xb0\xd9\xbc\x52\xab\x84\xaa\xd6\x6b\xe0\xd2\x79\x9c\x6b\x8a\x82\x3b\xe7\x3b\x14\x6b\xe3\xe2\x08\xf3\xf9\xed\x6e\x80\xec\x4f\xd8\xa7\x66\x87\x1d\x00\x39\x0d\x65\x32\x25\x99\xd7\x9c\xad\xd7\x90\x3e\xd0\x05\x0f\xb1\x7b\x8d\x3c\xa3\x47\x54\xd9\x31\xd7\x5b\xe4\x9e\xe9\xdb\xe3\xbd\x67\x85\x76\x13\x4c\xc9\x3f\x73\x49\xd5\x73\x9b\xa5\x1d\xfb\x5c\x92\x63\x13\x8f\x86\x01\xf6\x0b\x3c\x1c\xd7\xb6\x51\xe4\x98\x25\xca\x8d\xb0\x39\x2a\x98\xa3\x4a\x96\x2e\x7c\x18\x2f\x69\x23\x91\x9c\xb3\x2a\xb3\x8d\x0a\xed\xb8\x72\x7f\xfc\xee\x3b\x1d\x32\xdd\x20\x99\xe5\xce\x50\xb5\x65\xae\xe4\xca\xd4\xa2\xd0\x0a\x76\xbf\x92\x52\x48\x4e\x66\x79\xe6\x71\x33\x68\xc3\x4e\xbc\xf8\xbf\x24\x72\x6b\x3f\x23\x91\x5b\xdb\x2b\x31\xcf\x98\x78\xe9\x59\xa2\x58\x9f\xa7\x78\x31\x7b\xd0\x8a\xe7\x99\xe8\x0b\x0a\x45\xfc\xd9\x92\xe0\xff\x42\xfa\x84\x14\x1b\x07\xe4\x09\xb5\x31\x9c\x91\x5e\x09\xf3\x06\x51\x2b\xf0\xa7\x1d\x0d\x47\x40\x9f\x7f\x0e\xa9\x45\x72\xc6\xfd\xb8\x21\xc9\xec\xc9\xa3\xf2\xac\x65\xfc\x88\x0d\x17\x6a\xd0\xe6\x9c\x84\x76\xe7\x7b\x05\xda\x9c\x13\xd0\xe7\x4c\x7b\xca\xf4\x1a\x73\xa9\x2c\xcb\x5d\xb3\xe0\xef\x7a\xf3\x18\x60\x6f\x6a\x3b\x67\xcf\xa5\x4a\x8c\x9f\xcc\x7b\x0e\x85\x19\xbf\x2d\xe3\xb4\xee\x2e\xad\xc7\xfd\xeb\xa2\x2f\xe6\xe4\xce\x3b\xae\xe6\xbe\x79\x52\xc6\xde\x75\xf7\x8e\x31\x68\x2f\xcc\xd3\x89\x9d\x88\x44\xe6\x5f\x70\x7e\x33\x4f\xc9\x4f\x47\x67\x61\x3c\x4f\x7f\x93\x8d\x43\x6e\xd3\x3b\xb5\x0a\x97\x58\xdc\x41\xa7\xbe\xf4\x2a\x0e\xbb\x85\x4a\x79\xd9\x86\x81\x3b\x29\xfb\x17\xa4\xdf\xf4\xb3\x12\xa9\xc5\x0f\x48\xd4\xe6\x32\x85\xda\x7c\x27\x81\x0f\x1a\x77\x97\xfa\x25\x12\x43\x39\x5f\xa2\x31\xb0\x7e\x27\x91\x1f\x52\xd8\x6e\xfd\x4b\x24\xb6\xbb\xff\x63\x1a\x0f\xeb\xf6\xe8\x25\xd6\xbd\xbd\xf2\x2c\xbc\x36\xc3\x93\x35\xf3\xf2\x66\x57\xf1\x1a\xfa\x2f\x00\x00\xff\xff\x59\x43\x01\xdb\x70\x0e\x00\x00")
+var _assetsFaviconIco = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x8c\x31\x0a\x85\x30\x10\x05\x27\x90\x03\x6c\xf5\xeb\x94\xbf\xf7\x02\x1e\x47\xef\x7f\x80\x88\xc8\x83\x2d\x1e\x29\x14\x41\xc4\x81\x21\x10\xe6\x2d\x14\x0a\x11\xfb\xdb\x58\x2a\xfc\x80\x3f\x10\x40\xe3\xf8\x07\x98\x2b\xb7\xd3\xa7\xb5\x8f\x54\xe7\x70\xbd\x53\x7d\xc6\x75\x23\xaf\x6c\xe5\xb7\x7f\xc7\xfe\xcc\x0d\xed\x32\xae\x73\xaa\x77\xb8\x3e\xab\xee\x29\x6c\x03\x00\x7f\xe9\x89\xe9\x7e\x04\x00\x00")
+
+func assetsFaviconIcoBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsFaviconIco,
+		"assets/favicon.ico",
+	)
+}
+
+func assetsFaviconIco() (*asset, error) {
+	bytes, err := assetsFaviconIcoBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/favicon.ico", size: 1150, mode: os.FileMode(420), modTime: time.Unix(1792162013, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsJsPaletteJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x55\x4f\x6f\xe3\xb6\x13\xbd\xfb\x53\xcc\x8f\x08\x36\x14\x22\x53\xfe\x5d\x37\x91\x81\x22\xed\xa5\x29\xba\x05\xda\xdb\x62\x0f\x0c\x39\xb6\x88\x50\x24\x97\xa4\xe2\x15\xb2\xf9\xee\x05\xa9\x7f\xf6\x46\x4d\x0f\xbd\xc8\x30\x67\xde\xcc\x9b\x99\x37\x64\x55\xc1\x4f\xd0\x2a\xa3\x5a\xae\x41\xd8\xb6\xe5\x46\x82\xe3\x1a\x63\xc4\x12\xac\x43\x83\x12\x4e\x2a\x36\x40\x2a\x02\xd6\xc3\x7d\x2b\xb7\x0f\xd5\x7d\xf4\x7a\xfb\x50\x42\xcb\x9d\x53\xe6\xb8\xa9\xaa\x09\x1c\x20\x5a\x88\x0d\x82\xb7\x5c\x34\x12\x5b\x0b\xde\x76\x11\x03\x83\x47\x1e\xf0\x0f\x1e\x1b\x50\x01\x02\x46\x78\xec\xb3\xa3\xe6\xbd\xed\x22\xdb\x5c\xd1\x43\x67\x44\x54\xd6\xd0\x02\x5e\x36\x00\xcf\xdc\x2f\x51\x6b\xf8\xbc\x01\x00\x78\x31\xbc\xc5\x8f\x40\xb8\x94\x60\xac\x44\x52\x82\xb3\x21\x7e\x5c\xa2\xdf\x00\xa9\xb8\x94\xe4\xb5\xbc\x00\x84\xc8\x7d\x04\xae\xf5\x3a\x22\x9b\x93\xf5\x0d\xcc\xba\xf7\x50\xd6\xad\x80\x1c\xef\x02\xfe\x33\x2a\x9b\x57\x60\x1e\x43\xd7\xbe\x83\x1b\xec\x2b\xc0\xa3\x4d\x5d\x17\xba\x0b\x11\x3d\x29\xa1\xf1\x78\xf8\x01\xbb\x0e\xc1\x67\x34\x31\xac\x23\x46\xdb\x2a\x2e\xa0\x3e\x80\x68\x50\x3c\xad\x63\x93\x7d\x30\xbf\x6e\x00\xbe\xdc\x6e\x36\x00\x57\xec\x88\xf1\xd7\x3f\x3f\xfd\x4e\x2f\x5c\xb9\x53\x55\x1a\x64\x62\x31\x0b\x20\x1f\x0c\x2a\x48\x40\xe4\xa2\x19\xce\xce\x7c\x54\x99\x05\x30\x79\xc1\xac\x15\xe6\xba\xd0\xd0\x4b\xc2\xc9\x13\x08\xdc\x64\x08\x4b\xa6\x55\xde\xc9\x5a\x5d\xb8\xbd\x16\xb7\xef\x86\xcf\xba\x59\x0d\xbf\x32\xc0\xb7\xe1\x17\xed\x91\x7f\xcf\x64\xdd\x7f\x4e\x64\xdd\x9c\x67\xf8\x4d\xdf\x71\xd7\xc6\xb5\x87\x1a\xae\xe8\xf5\x9d\x54\xcf\xa0\x64\x4d\xc6\x53\x02\x42\xf3\x10\xd2\x7f\x83\x1a\xf2\x77\x2b\xf1\xc0\x3b\x1d\xc9\xfe\x1a\x6e\x72\xcc\x01\x76\xee\xb9\x7d\xb4\xb2\x27\xfb\x3b\x65\x5c\x17\x21\xf6\x0e\x6b\x12\xf1\x5b\x9c\xe3\x1d\xac\x6f\xb7\xc2\x9a\xe8\xad\x26\xe0\x34\x17\xd8\x58\x2d\xd1\xd7\x64\xec\xc3\x79\xf8\x4e\x4f\x38\xad\x42\xdc\x76\x26\xc4\x5e\xa3\x24\xfb\xbb\xaa\xd3\xfb\xbb\x4a\xaa\xe7\xf1\x7b\x5d\xb0\x46\x49\xa4\x05\xe3\xce\xa1\x91\x7f\x59\x4a\x32\x97\x5c\x76\xba\x5b\x06\x4a\xf5\x74\xdd\xb1\x83\x32\x92\x92\x7c\xba\x38\xa5\x34\x6f\x7c\x3a\x9d\x1c\x36\x30\x8b\x11\x5a\x1e\x45\x83\x61\xbc\xb9\x86\x7e\x7e\x85\x1a\x72\x34\xf6\xcc\x35\x2d\x58\xb4\xbf\xd9\x13\xfa\x7b\x1e\x90\x8e\x33\xf0\x18\x3b\x6f\xd2\x6a\x78\x74\x74\x9a\xfb\x99\xc8\x45\x01\x2f\x93\x97\xc8\xf3\x66\xca\x48\xfc\xf6\xe9\x40\xbf\x16\xb0\xaf\x61\x77\x3b\x0d\xf2\x82\x4f\xe7\x24\x8f\x38\xd3\x49\x55\x30\x6c\x5d\xec\xa7\xcc\xe3\x52\xcd\xbc\x59\xd0\x4a\x20\xdd\x95\xf0\xff\x5d\x71\xb9\x65\x62\x8a\x02\x70\x45\xc9\x9d\x56\x7b\x52\xb0\x34\x43\x3a\x30\x4a\x95\x1d\x8f\x1a\xef\xd3\x60\x68\x9e\xee\xd6\x79\xd5\x72\xdf\x93\x12\x14\xd4\x35\xec\xce\xa6\x90\xb8\x5c\x4a\xf0\x82\xb9\xef\x0c\x9d\x33\xaa\x03\x50\xc1\xd2\xa2\x2e\x1c\x4e\xca\x48\x7b\x62\xda\x0a\x9e\x00\xd9\x0a\x35\x0c\x6e\xd3\x0a\x0d\x2d\x1b\xb3\xe4\x6f\xd2\x74\xd2\x1a\xb4\x18\x1b\x9b\x84\x6d\x43\x52\x6e\xc1\x78\x8c\x9e\x12\x9e\x07\x49\x4a\x10\x2c\x6d\xee\x5b\xd9\xb0\xd0\x3d\xb6\x2a\xd2\x15\xce\xe9\x9d\x9c\x7b\xbd\x8c\x9c\x90\xb1\xce\x69\x1a\xc3\xbf\x49\x4b\xa1\xb1\xa7\xe9\x6c\x00\x1d\xac\xe8\xc2\x59\x82\x2b\x2a\xad\xe8\x5a\x34\xb1\x60\x4f\xd8\x4b\x7b\x32\xcb\x23\x89\x53\xc2\xa4\xb5\xd8\xa7\x77\x38\xaf\x2e\xb2\xc8\xfd\x11\x63\xc1\x54\x18\xe5\x5c\x42\x1a\x0a\xf7\xc8\x27\x46\xa9\xb1\x14\x53\xd0\x34\x1e\xf2\x44\xe0\xc3\x07\xa0\xc8\x5a\x8c\xfc\x01\x7b\xf8\xfe\x1d\x90\x89\xe8\xf5\x03\xf6\x45\x91\xfe\x9e\x79\x57\xd9\xfb\x7f\x43\xce\x62\xa2\x01\x80\xcc\xf9\xfc\x6a\xfc\x3c\xdc\x0b\x53\x71\x30\x36\x68\x19\xc7\x78\xeb\x64\x72\xcc\x9a\x69\xeb\xca\xb1\x53\x19\x97\x8f\xde\x29\x5b\x1d\xce\x39\xfd\x12\x04\x77\x48\x16\x32\x53\x97\x87\x4b\x60\x4c\x0d\xa8\x03\xfe\x88\x34\xe9\xd1\x5c\x80\xa9\x9d\x2d\xd4\xcb\x4a\x4f\x45\x24\x58\xcb\x34\x9a\x63\x6c\x60\x0f\xbb\x05\x02\x59\xb5\xed\xe7\xdd\x97\xd9\xf9\xf5\xa2\xd6\xd7\xe2\x76\xf3\xf7\x00\x07\x1d\xd2\x04\x6b\x09\x00\x00")
+
+func assetsJsPaletteJsBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsJsPaletteJs,
+		"assets/js/palette.js",
+	)
+}
+
+func assetsJsPaletteJs() (*asset, error) {
+	bytes, err := assetsJsPaletteJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/js/palette.js", size: 2411, mode: os.FileMode(420), modTime: time.Unix(1792161709, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesClusterHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5c\x7b\x73\xe3\x36\x92\xff\xdf\x9f\xa2\x8f\xeb\x3d\xc9\xb5\x16\x65\x8f\x33\xb9\xac\x46\xd2\x95\xe3\xc9\x6c\x5c\xf1\xcc\x3a\x96\x9d\x54\xed\xd5\x55\x0a\x22\x21\x09\x31\x09\xf0\x40\xd0\xb6\xe2\xd5\x77\xbf\x6a\xbc\xf8\x10\xf5\xb0\xe3\xdd\x8c\xab\x6c\x91\x68\x34\x7e\xe8\x6e\x34\x1a\xdd\xd0\x0c\x73\xb5\x4c\xe8\xf8\x00\x40\xc5\xb0\xf8\x0a\x9e\x0f\x00\x00\x52\x22\xe7\x8c\x0f\xe0\xe4\xc3\x01\xc0\xea\xc0\xb4\x66\x92\xda\xe6\x29\x89\xee\xe7\x52\x14\x3c\x1e\x00\x17\x9c\x22\x15\xc0\x54\xc8\x98\xca\xf2\x8d\xe9\xb7\xa0\x24\x06\xb5\x68\xe9\xf9\xa7\xd9\x7b\xfc\xf1\xa4\x61\x4a\x9e\x16\x94\xcd\x17\xaa\x32\x94\x78\xa0\x72\x96\x88\xc7\xde\x72\x00\x79\x24\x45\x92\x7c\xb0\x08\x9f\x7a\x86\x78\x00\xdf\x9c\x64\x4f\x25\x17\x2e\x62\xda\x13\x85\xca\x0a\x65\x79\x98\xd9\xf4\x94\xc8\x06\xf0\xbe\x4a\xaa\xc8\x34\xa1\xa0\xe4\x60\x81\xc3\x58\xea\xa8\x90\xb9\x90\x03\xc8\x04\xe3\x8a\xca\x92\x3a\x23\x9c\x26\x10\x66\x52\xcc\x25\xcd\xf3\x16\xe6\x5f\x67\x4f\x75\x51\x9c\x66\x4f\x90\x8b\x84\xc5\xf0\x27\x42\x48\xc9\x2a\x11\xd1\x3d\x8d\x2d\x87\x8c\xc4\x31\xe3\xf3\x5e\x42\x67\x6a\x00\xdf\x38\x1e\x0f\x54\x2a\x16\x91\xa4\x47\x12\x36\xe7\x03\x50\x22\xfb\x50\xa3\xd7\x43\x7a\xf2\x48\x24\x88\xba\x3e\x4e\x24\xb8\x22\x8c\xfb\xb9\xa1\xd4\x1e\x59\xac\x16\x28\xb4\x9a\xd4\x22\x91\x14\x29\x6f\x4c\x6a\x2a\x94\x12\x69\x43\x68\x8e\x32\x8c\xa5\xc8\x62\xf1\xc8\x7b\x29\xe5\x05\x24\x64\x4a\x13\xdb\x3d\x66\x79\x96\x90\xe5\x00\xa6\x38\xd1\x1a\xea\x01\x9c\x65\x4f\x70\xfa\xce\xc1\x9e\x09\xae\x7a\x8f\x56\x91\x5c\xc8\x94\x24\x6d\xf0\x43\x34\x23\xc6\xe7\xb0\x78\xd7\x1c\x83\xf1\x84\x71\xda\xf3\x43\xad\x0e\x86\x7d\x6b\xd4\xc3\x3c\x92\x2c\x53\x68\xdd\x87\xdd\x59\xc1\x23\xc5\x04\xef\x1e\x59\x0e\x87\xdd\xe0\x7f\x62\xa2\x48\x4f\x89\xf9\x3c\xa1\xa3\x8e\x12\x22\x51\x2c\xeb\xfc\x6f\x70\x14\xda\xcf\xdd\xa3\x0f\x96\xb6\x13\x46\x22\x5b\x76\x8e\xc2\x28\x61\xd1\xfd\x3a\x37\x00\x4e\x1e\xd8\x9c\x28\x21\x91\x24\x9b\x0a\x22\xe3\xf0\x51\x32\x45\x6f\xe9\x93\xea\x1e\x76\xd5\x82\xe5\x47\x21\x8e\xd8\xed\x18\x5e\x96\xf9\xaa\x36\x88\x91\x2e\xe3\x59\xa1\x70\xb4\x05\xe1\x73\xda\x36\xdc\x03\x91\x70\x4f\x97\x39\x8c\xd6\x3b\x0e\xa2\x05\x45\x0b\xeb\x1c\x85\x29\xc9\x6a\xbd\x41\x52\x55\x48\x0e\x88\x26\x7c\x20\x49\x41\x3f\xc0\xea\x28\x9c\x53\xd5\x3d\x0a\x7f\x15\x8c\x77\x3b\xc7\x1d\x0b\x08\x20\x16\x51\x91\x52\xae\xc2\x48\x88\x7b\x46\x61\x04\x1d\x3b\xd0\xa8\x03\x7f\x31\xe3\xff\x05\x3a\x1f\x20\x23\x6a\xa1\x5f\x75\xa7\x24\xa7\xd7\x44\x2d\xe0\x9f\xff\x84\x4e\xbf\x73\x64\xda\xd1\xf2\xc8\x9c\x8e\xce\x4e\xdf\x9f\x7d\x7d\x72\x72\xd2\x71\x23\x24\x22\x22\xa8\x96\x50\xd2\x44\x90\xb8\xbb\x45\x26\x75\x8b\x5b\x57\x05\x2d\x85\x43\xc3\x5c\x89\xec\x5a\x8a\x8c\xcc\x35\xfb\x36\xbe\x6e\xf9\x6f\xe5\xc4\x66\xd0\x3d\xec\xd2\x50\x11\x39\xa7\x0a\x29\x45\x4e\x73\xd5\x0d\xc8\x31\x4c\x0b\xa5\x04\x0f\x8e\xc2\x84\xf2\xb9\x5a\x94\x9d\xc0\x4a\xd9\x4d\x72\x65\xff\x2e\x24\x9d\x69\x7d\x95\xfc\x32\x22\x29\x57\x79\xb7\xa3\x71\xcc\x18\x8f\xbb\x81\x8a\x81\x04\x47\x21\x51\x4a\x76\x3b\xd8\xa7\x54\x08\xc2\xc1\x37\xf0\x1f\x23\x28\x78\x4c\x67\x8c\xd3\xb8\x3a\xf0\x23\xe3\xb1\x78\x0c\xbd\x58\xed\x90\xf8\xa7\x8e\xc6\x48\x02\x7f\x0f\xfb\x6e\x9d\x0c\x63\xf6\x00\x51\x42\xf2\x7c\x14\xf8\xc5\x17\xe0\xfa\x79\x7e\x86\x47\xa6\x16\x10\x5e\x24\x45\xae\xa8\x0c\x3f\xd2\x54\xc0\x0a\x59\x55\x3b\x19\x1f\xa9\x7f\xf7\x62\x3a\x23\x45\xa2\x74\xf7\x16\xaa\x9e\x5d\xd2\xc1\x38\x12\xd1\xbd\x14\x24\x5a\x40\x8c\x4c\xff\x33\x65\x71\x2c\xd4\x07\x78\x7e\x86\x70\xa2\x88\x2a\x72\x58\xad\x86\xfd\x98\x3d\x58\x56\x46\x71\x96\x99\xd5\x22\xfe\xee\x19\xbf\x4b\x63\x3b\x26\x92\xe2\x28\xee\x09\x9f\x65\xf9\x80\x8f\x0b\xd0\xfe\x70\x14\xbc\x3f\xc9\x9e\x82\xf1\x17\x11\xd3\x61\x5f\x2d\x1a\x44\xe3\x9f\xe9\x14\xee\x2e\xdb\x5a\x26\x3f\x5e\xd5\x5f\x0f\xfb\xe5\x18\xc3\x7e\x6d\xfc\xa1\x9a\x8a\x78\xe9\x9e\xb4\x50\x25\x2e\x6f\x08\x71\x5c\x9c\xa5\x6f\x5a\x83\x8a\x2f\xe2\x31\x8a\xe4\xf2\xa3\x16\x87\x8a\x5b\x9b\xd9\x0c\xc2\x9f\xe9\xf4\xee\x12\x89\x88\xd6\xfb\x28\x78\x7e\x2e\x5f\x06\x60\x4c\x6f\x14\xfc\x32\x4d\x08\xbf\x0f\xc6\xd5\xd6\x61\x9f\xe0\x33\xe5\xf1\xc6\x41\x86\x91\x88\x29\x12\x85\x93\x1f\xaf\x34\x95\x7e\xd1\x24\xae\xca\x41\x4f\x95\x26\x39\xdd\x3d\x45\x88\x44\x92\x67\x84\x8f\x82\xb3\x60\x3c\x64\xe3\x9f\x09\x53\xe8\xf8\x67\x42\x42\x24\x38\xa7\x7a\x85\x02\xe3\x33\x31\xec\xb3\x3d\x46\xd5\x33\xb1\x6f\x86\xfd\x8a\x06\x86\x7d\x6d\x3a\x48\xed\x8d\xab\x06\x73\xcd\xe6\x27\x45\x9a\x12\xb9\xfc\x7d\x66\x8f\x00\x4a\xfb\xcc\x95\x14\x7c\xae\xa5\xe9\x6c\x00\xb7\x2f\xfd\x12\x30\x94\xc9\x07\x9e\x34\x23\xdc\xb1\x52\xf4\x49\xf5\xf2\x22\x8a\x68\x9e\x1b\x05\xde\x14\x9c\xa3\x9c\x56\x2b\x90\xe6\xe3\xb0\x8f\x72\x1c\x1f\x6f\xec\x1f\xa3\xed\x49\xd3\x7d\xa2\x44\x96\x51\x14\x15\xa0\xe7\xcc\x68\xbc\xb3\xfb\x23\x91\x38\x8c\xe9\x7f\x4d\x8a\xdc\x74\xcf\xf4\x27\xdb\xdb\x76\xf6\x4b\xba\x3a\xdf\x1b\x9a\x2b\x22\x55\x7d\xca\xd2\xbe\xb4\x1d\xad\x41\x5f\x5c\xdf\x5d\xb1\x94\x29\x3d\x42\x2b\xb3\x9f\x2e\xae\xef\xea\x9c\x1e\xf0\x4d\xd3\x00\x5a\xfb\x7e\x64\xf9\xfd\x5d\x4e\xe6\xb4\xd6\x5f\x70\x8c\x31\xee\x9b\x1d\x8b\xac\xda\x17\x57\xdb\x5d\xa6\x58\x8a\x7d\x9f\x9f\xeb\x0f\xd6\x92\x7a\x1e\x84\x67\x6e\x99\x3a\x03\x3b\xf4\x16\x76\xc9\x99\x32\x9d\xd9\x0c\xe8\xff\x79\xff\x17\x30\xce\x14\x23\x09\xfb\x8d\xc6\x41\x5d\x06\x1b\xad\xa2\xd2\xc5\x6a\xc3\x03\xf1\x1f\x4a\x20\x87\x18\x7e\xc2\x60\x54\x01\xa3\x0d\xf2\x0a\x5f\x57\x09\xd9\x0c\xe6\xd4\x92\x9f\x94\x2d\x15\x11\x49\x21\x52\xbd\x5e\xad\xa0\x1c\x3c\xa3\xcc\x44\xd9\xce\x67\xb0\x5a\x55\xec\xd0\x63\xd2\x06\x65\x48\xaa\xfa\x48\x85\xa4\x66\x45\xc0\x94\x26\xe2\x11\x7a\x18\x53\x64\x42\xaa\x12\x5b\x73\x52\x0d\xe9\x7e\x2f\xf2\xca\x5c\x86\x53\x39\xde\x68\xdc\x69\xa1\x70\x1b\xc1\x1e\x83\xba\x2d\x5b\xad\x7f\x4f\xf2\x8b\xeb\x3b\x58\xad\xa2\xac\x68\x9f\x28\xfa\x75\x24\xf9\xeb\x49\x78\xb2\x6d\xaa\x99\x64\x5c\xcd\x20\xf8\x73\x78\x32\x0b\x4c\x97\xd5\xea\xcf\x7e\xe2\xa5\x21\x55\x46\x1a\xf7\x6a\xed\x8d\x69\xa3\x55\x7e\xa6\xe9\xad\x50\x24\xa9\x1a\x4b\x4a\x53\x21\x97\x9b\xd1\x7e\xa6\xe9\x35\x95\x11\xe5\x6a\x27\xe8\xf0\x33\x4d\xab\xea\xd9\x80\x02\x97\xd6\x1a\x8c\xd6\xf1\x13\x65\xa8\x2d\x80\x4f\x92\x52\x38\xdd\x05\x02\x3b\xd4\x8c\x04\x57\x2c\xcc\x24\xa5\x2d\x78\x2a\xcf\xde\xdf\xd7\x1c\xbf\x6b\x37\xd8\xb9\x50\xa5\x4f\x6e\xf8\xfb\x5f\x8b\x74\x2a\x70\x48\xd0\xd8\x50\x62\x36\x4e\x02\x18\x66\xe3\xdb\x05\x46\x27\x3a\x4e\x82\x05\xc9\x81\x0b\x6b\xb8\x4b\xaa\xc2\x61\x3f\xb3\x84\x33\x21\x53\x48\xa9\x5a\x88\x78\x14\x64\x22\x77\x7b\x06\xc0\xd0\x44\x96\xb8\x88\x52\xa2\x37\x3c\xad\x26\x0c\xad\x61\xb5\xea\x93\x38\x0e\x1c\x94\xa9\xe2\x30\x55\xbc\x97\xcc\xf5\x1f\xbf\xfa\xcf\xe3\x18\x96\xa2\x90\x30\x63\x32\x57\x7a\xfc\x61\xdf\xb0\xb5\xc3\xf7\x91\xfb\x0b\x76\xbf\x1f\x0b\x21\x8b\x74\x5d\x18\x24\xa1\x52\x55\x85\xe6\x09\x75\x4b\x45\x73\x68\xc6\x68\x9b\xe7\xea\x06\xf5\xe4\x08\xec\x46\x52\x8e\x6e\x5e\xdb\xa9\x78\xcd\x38\xf9\x5a\x5d\x9b\x51\x06\xad\x03\x5f\xfd\x7d\x72\xdb\x3a\xe0\xf9\x2d\xdc\x5c\x4e\x7e\x28\x87\xfa\xfb\x0f\x9e\xbf\xb7\xa2\x83\x9a\x37\x6b\x6c\xae\x62\x86\x23\x86\xce\xa8\xad\x62\xed\x96\x7b\x0c\x92\x66\x09\x33\xa1\x37\xcc\x48\xa4\x84\xd4\xe4\x37\xe5\xeb\x4f\xe6\xed\x6a\x65\x36\x66\x6c\xbd\x15\x09\x95\xc4\xec\x6e\x9a\x21\xcc\x08\x4b\x0a\x49\x73\x50\xae\x69\x8b\xb1\xd6\xd5\x64\xb7\x10\x6f\xc7\xad\xbb\x08\xee\xdb\x9b\x34\xa9\x7f\xf7\x30\xc0\x6a\x48\xfc\xb2\xd2\x1b\x54\x69\xe3\x83\xba\xe4\xec\xd2\x3f\x57\x8a\xa6\x99\xaa\x44\xb5\xc4\xbc\x41\x5c\xd5\x56\x3d\x59\x1a\xa3\xec\x94\x5c\xa2\x98\x89\xb2\x42\x53\x72\x19\x7e\xc2\x35\xa0\x20\x38\x7d\x3f\x38\xf9\x6a\x70\xf2\x1e\xb7\xbf\x01\x94\x41\xe8\x15\xc9\xd5\x77\x52\x0a\x59\x86\xa2\x0e\x86\xd5\xb1\x1d\xde\xea\xc8\x76\x2d\x0f\x1d\x28\x94\x66\x47\x27\xdd\xb5\xa5\xd1\x10\xa8\x81\xbe\x43\x94\x2e\xce\xaa\x09\xd3\xaa\x0b\xbc\x4a\x9c\xcd\x20\x43\x2f\x50\x20\x33\x24\x6a\x4a\xcc\x4a\x32\xdf\x2d\x87\x4d\x36\xb3\xd9\x7e\xd0\x54\x89\x8d\x71\xad\x2a\x3f\x31\xce\xf2\x05\x8d\xc3\xcb\xfc\x1f\x54\xba\x63\xdf\xba\xfb\x82\x16\x5f\x85\x0b\x82\x2c\xfb\x11\xe1\x11\x4d\x82\x36\xf1\xb4\x58\x9a\xc1\xc0\xf8\xbc\x14\x44\x39\xd1\x4f\x2c\xa1\xe5\x1c\x9d\xb1\x4c\x32\xaa\xd7\xcf\xd3\xc0\x29\x31\xfc\x28\x38\x6d\x5b\xb2\x06\x65\x0e\xb1\xe0\xb4\xb2\xf9\x20\xb5\x27\x3a\x06\x4e\x9f\x94\x63\xfe\x85\x3e\xa9\x56\x43\xac\x4a\xb2\x74\xdb\x0d\xd7\xfc\x94\xeb\x3f\xfe\x60\x70\xa1\x65\x51\xf5\xc6\xa5\x2f\xde\x5b\x4d\x93\x68\x41\xe3\x22\xa1\x2f\x52\x46\x6e\x3b\xbd\x50\x1d\x9f\x09\xe3\x8a\x72\xec\xd3\x58\xeb\x24\x49\xac\x07\x2c\x9d\xcd\xb9\x16\x2f\x04\x78\x8e\xc0\x95\x81\x7f\xcb\xc5\xa8\xc3\x7b\x3f\x33\x27\xe0\xf3\x76\xf1\x42\x97\x71\xb4\xd0\xf0\x92\xc3\x6a\x75\xe4\x34\xeb\xfc\xfd\xed\x82\xf2\xd2\x26\x09\x8f\x41\x73\x07\x32\x27\x8c\x3b\xd6\x9a\xe8\x8f\xd0\x1d\x9b\x95\xda\xfa\x56\x08\x95\x2b\x49\xb2\x8f\xe2\x91\x6f\xf7\x16\xfe\x58\x55\x53\x81\x67\xa0\xc5\x0d\x98\x77\x2d\x55\x01\xf4\x81\xca\xa5\x69\xc1\x14\x5e\x0e\x6a\x21\x45\x31\x5f\x98\x57\xa7\xc7\x90\xbb\x08\x24\x22\x1c\x03\x9b\x29\x05\x12\xc7\x7a\x57\x01\x40\xc1\xd9\x73\x17\x8d\x2d\x5d\x4a\x96\x30\xa5\x50\x70\x3c\x22\x83\x12\x20\x29\x72\x86\x82\x2b\x96\x00\x53\xc0\x72\xb0\x3d\xc2\x2d\x6e\x46\xbb\x16\xc6\x63\xfa\x54\xca\xc2\xc4\x54\xc1\x69\x8b\xd7\x7c\xa4\x49\x02\xf8\xab\x97\xa7\x0d\x01\x5c\x98\xb3\x7f\xc3\xfe\x4a\xaf\x60\xdb\x2f\x44\x9a\x12\x1e\xd7\x7c\x60\xa9\x5c\xb5\xcc\xe8\x28\xb0\x69\xbb\xed\xaa\x06\x4c\xd1\x06\x80\xe9\xda\x1e\x7e\x1c\x05\xad\xa3\x04\xa0\x98\x4a\x28\xa6\xcb\xb2\xa5\x4b\x50\x40\x64\xda\x83\x71\xed\x60\x31\x4f\x96\xd9\x82\x45\x82\x83\xff\xd4\xcb\x48\x46\x25\xe6\x8b\x83\xb1\x3d\x66\xd4\x6d\x6b\x8b\x58\xbd\x40\xef\xb2\xb9\x24\xf1\xcb\x3c\xc1\x8c\x71\x7d\x9a\xec\x15\xa6\x73\xc3\x15\x58\xf3\xfd\xcc\x9e\x68\xbc\x2b\x4e\x43\x87\xe1\xf1\x6d\xd8\xe5\x1e\xa8\xcc\x99\xa8\x9a\x6c\x7d\x81\xfc\x64\xda\xed\x21\xba\xed\xa5\x1d\x72\xc8\xc6\x05\xbf\xe7\xe2\x91\x1f\x5b\xe3\x46\x4b\x44\x93\xb6\xdb\x3b\x26\x85\xaa\xd2\xaa\x44\x72\x0e\xd4\xb7\x8c\x13\xc9\x68\xde\xb0\x25\x9f\x8d\x3b\x64\xc7\x70\x38\xc5\xb3\x70\xe8\x48\xfd\x99\xfc\x90\xe9\xcd\xc1\x8f\x80\x47\xd5\x69\x58\x22\x85\xae\x77\x87\x96\xd9\xaf\xc7\x70\xc8\x91\xd9\xe1\xd4\x1f\x27\x2c\xaf\x5f\xd7\x79\xb9\xd9\x6a\xe6\x47\xfe\x53\xc5\xf3\x79\xa5\xd8\xb0\x06\x8f\xb1\x5f\x5c\x10\x0a\xa9\x6e\xb4\xe2\xce\x07\x60\xd5\x5b\xf5\x10\x53\x3a\xc3\xa3\xb4\xb5\x00\xc6\xe7\xa1\xe3\xa4\x8b\x0e\x76\x91\x2c\x58\x1c\x53\x1e\x00\x27\x29\x1d\x05\x33\x21\x23\x1a\x80\xae\x17\x8c\x02\x25\x0b\x6a\x15\xbd\xcb\x71\x3a\x6f\x06\x82\xeb\xc2\xc9\x28\xb0\xf5\x87\x48\xf0\x19\x93\x69\xb7\xb3\x09\x7b\x08\x9f\xac\x8d\x02\xe1\xcb\x47\xb2\xfc\xef\xce\x51\x30\xf6\xef\xce\xf5\xbb\xea\x62\xa9\x05\x69\xeb\x86\xb5\x17\x5c\xe7\xe7\xdd\xaa\x9e\x7c\x77\x0b\x17\x57\x77\x93\xdb\xef\x6e\x60\xf2\xdd\xed\xed\xe5\x97\xbf\x39\x80\x30\x82\x48\xc6\xd3\x5f\x18\x9e\xfd\x38\x49\x42\x54\xfc\x2f\xf4\x89\x46\x85\xce\x2b\xfe\x62\xe9\xba\x55\xd4\x76\xa9\xae\xc3\x76\x5a\xde\x1d\x09\x54\xfd\xa5\xab\x8c\xe8\x53\xa8\xc4\x22\x9a\x5b\x7f\x15\x22\x9c\x1e\x56\x6b\xb3\x35\x95\xbd\xc4\x1d\xfa\xda\x8b\xa9\x96\x05\x50\x2d\x9d\x05\xae\xb5\xe2\x0e\x0d\xb2\x7c\x81\x1b\x1e\xc3\x7a\x93\xc9\xde\x80\x16\x8e\x87\x02\x70\x61\xe7\x50\x73\x95\x11\x91\x54\x79\x97\xe8\x60\xd7\xa4\x56\x9f\xa4\x87\x87\xa5\xa1\x12\x2c\x3e\xd5\x04\x53\x4f\xbb\x7f\x24\xf9\x42\x17\xea\x1c\x08\x6f\x26\x00\x43\x5d\xd1\x1c\xd7\x16\x85\x2e\xab\x4d\xc5\x93\x5f\x09\xb8\x27\xfc\x40\x31\x5a\xb6\x69\x0c\xb3\xd7\x1d\x86\x8e\xa1\x6d\x05\x5b\x90\xf3\x8a\xd4\x0e\x30\xbc\x45\x2b\x43\xaf\xd6\x37\x83\x55\x21\x7a\x85\xd7\x12\x16\x95\x8f\xe5\x87\x75\x97\xbf\x6f\xa1\xc4\x3e\xea\xfa\xf7\x9b\x16\x4d\x9c\x7b\x37\x62\x08\x0b\x89\x91\x77\xa5\x27\x29\x94\x08\xc6\x77\x37\xa6\x78\xd2\x9c\x6e\x0b\x07\xb7\xea\x34\x97\xb1\x5d\xd6\xfb\x76\x2e\x5c\x8a\xb6\x82\x00\x6f\x0d\x04\x63\x93\xaf\xdd\x97\x8f\x8d\x92\xf2\x3a\xa7\xff\xd2\x9c\x5c\x3a\x7b\x5f\x5e\xb1\xcd\x5d\x35\x11\x61\x4e\x6b\x5f\x1e\x85\xcb\x5a\x37\x99\xdc\x61\xc3\xbe\x5c\x12\x31\x6f\xcc\xe8\xd4\x54\xc3\xae\xc4\x7c\xef\xe9\xb8\x43\x55\x1b\x1f\x73\x22\xd8\xc4\xea\x35\x05\xb3\x43\xed\x4a\x06\xa3\x5a\x86\xce\xfd\x0c\x95\x74\x36\x6f\x41\x22\x80\x07\x9b\x95\x2f\x9f\xcb\xa2\xc5\x5a\x64\xd3\xcc\x3d\x95\x2d\x6b\x79\xc8\xca\xc0\x38\x74\x65\xdd\xd8\x57\x95\x22\x9c\x8b\xbe\x10\x7d\x1f\x1d\xc0\x17\xa2\x8b\x05\xc1\xb8\xf2\x80\x25\xb8\x06\x0f\x0b\xfb\x9a\x71\x4e\x63\x1d\x93\xa0\xcb\xc1\x9d\xbf\xe6\x35\xb5\x13\x31\x37\x31\x7a\x99\x64\x58\xab\xf2\x0e\x39\xd3\x7d\x31\x92\x7f\x7e\x5e\xe3\xb4\x47\xa0\x8a\xd7\x2b\xbc\x43\xae\xf0\x28\x37\x58\xdb\xe6\x45\xb3\x3e\x07\x13\xb6\x4e\x94\x90\xf4\xca\xdc\x80\xd9\x31\x09\x9b\x39\x71\x73\x20\x5c\xa8\x05\x95\x90\x49\x81\x85\x2f\x8c\xfc\x8a\x1c\x53\x3a\x08\x47\x4f\x23\x47\xde\x80\x58\x69\xbc\x1b\x4f\x23\x99\x63\xb3\x7c\xf6\x6c\x68\xc0\x7e\x4f\x49\xa2\x16\x3e\xaf\xb2\x0d\xac\x0f\x75\x2c\x5a\x0f\x4a\x47\xa7\x94\xc4\xcb\x26\xa2\x2d\xd0\xcc\xe8\x4d\xbb\xbd\x21\x11\x75\x30\xb6\x98\x95\x5e\x1d\xce\x9c\xfa\xb2\xe0\x7d\x5f\x37\xf6\xdb\xbc\xbd\xaf\x63\xd3\xf4\x44\x11\xe4\x8d\x0b\xa9\x2a\xf9\xd2\xec\xcd\xdb\x7a\xa8\xef\xd4\xe2\x12\x6b\xb8\xc5\x4b\x44\x38\xd5\x16\x1a\x8c\xf1\xc1\xcf\xa5\x3a\xc6\xc0\x69\xcc\x33\xab\xd5\x9d\xdb\xe5\xd2\x2c\xf5\x3a\x15\x1e\x36\x76\x99\x97\x2c\xc8\xf0\xee\xe6\x6a\x63\x4d\xdc\xb4\xb5\x2c\xc7\x37\x3b\x4e\xfa\xd1\x2b\x67\xc8\xbb\x9b\xab\xdf\x7d\x6e\xac\xfe\x54\xca\x5c\xee\xa7\x3c\x35\x4f\x7e\xbc\x72\xb3\x2c\x4f\xcb\xff\x82\x89\xfa\x71\xea\x73\xc5\x0b\x04\x6f\x3d\x5f\x9b\x1f\xa2\x7a\x72\xd7\x42\x2a\x08\xf5\xef\xd5\x6a\x98\xa7\x98\xaf\x6a\x29\xf3\xdd\x5c\x5f\xa0\x31\x39\xc2\x63\x0d\xcc\xe2\x76\x9d\xfb\xba\x77\x69\xa4\x2f\x71\x27\x0d\xda\x7f\x4b\x42\xa2\xbd\x75\x6c\x5f\x6d\x91\xde\xbe\x4b\xaf\x85\xae\xb9\x20\xcb\xa0\xad\xed\xaa\x8a\xf1\x71\xdf\x16\x2c\x41\x46\xc6\xe4\x6b\xe0\xd3\x54\x97\x32\xcc\x6a\xbc\x25\x73\xbd\x1a\xbd\x0b\x5d\xaf\xae\xbf\x0e\xa4\x0f\x0e\xdb\x30\xbe\xb4\xc0\xff\x3a\x08\x95\xb8\xb2\x0d\x44\xfd\xaa\xc4\xeb\x86\xb0\xe1\x66\x2b\xfb\xef\x8b\x94\xf0\xfa\x2d\x88\xd7\x0d\xe2\xe2\xd1\x5d\x2e\x18\xf5\x79\x71\x7d\x37\xc9\x88\xbc\xc7\xdb\x9b\xcd\x2e\x86\xe2\x33\x4d\x37\x52\xbc\x16\xa1\x8d\x75\xf7\x00\x58\x8b\x1b\x1b\xcd\xf5\xb3\x27\xee\xb6\x3d\x59\xf0\x46\x2c\x68\x09\xc9\xf6\x65\x1d\x6c\xde\xc6\xd7\x76\x70\x1b\xb6\xea\x0b\x60\xfd\x5c\xc5\xa2\x50\x7b\xb8\xce\x19\x4b\xa8\xf7\x9a\x60\xba\xb5\x6c\x6a\xe5\xb4\x31\x5e\xb1\x53\x0f\x3f\x53\x39\xaf\x66\x9b\xea\xff\xfe\x95\x93\xa3\x52\xbe\x66\x72\x54\xca\xcd\x93\x6b\x31\x90\xc6\xe9\xba\xfc\x29\xd7\xf7\x3a\x3d\x1b\x7f\x11\x9c\x62\xaa\xf1\x60\x9f\x31\x5e\x6b\xac\xe5\x99\x6a\x3f\x7b\xad\xee\x3e\xf6\x42\xd7\xd6\xdd\x67\xc3\xf5\x82\x35\x15\x69\xe7\xb4\x69\x7b\xb2\x47\xa5\x60\x3c\x41\xaa\x6d\xfb\xca\x26\x69\xbe\x18\x8d\xc8\x36\x81\x71\xa5\x56\x9c\xfd\x26\x28\x6d\xd2\xfa\x87\x48\xa7\x8c\xb6\x0a\xeb\xe5\x00\x25\x7d\x60\x0f\x74\x13\x44\x2f\xaf\x1b\x4d\xb6\x15\x65\x5b\xc9\xd9\x1c\x5a\xdf\x0c\x6a\x5e\xa4\xfb\x40\x45\xb2\xdd\x50\xdf\x04\x93\xbe\x40\xb8\x4b\xc1\x5a\x0a\xdb\x01\xad\xaf\xaf\xdf\xb7\x3e\xb7\x5f\x2d\x6d\x49\x93\x35\x78\xd6\x32\x89\xbc\x48\xa7\x78\xb2\x35\xe9\xf5\x48\x14\x5c\xf9\x29\x6b\x3a\xac\x80\x41\xca\xf8\x08\x0b\x65\x29\x79\x1a\x05\x67\xef\x7c\xda\xf1\x34\x00\xfd\x15\x87\x51\x60\xbf\xcd\xa1\xf3\x2c\x2e\x78\x32\xbc\xb1\xec\x8d\xb2\xc5\x3b\x24\x58\xec\x6b\x66\x2a\x5e\x7e\xc5\xa8\x69\x15\x78\xc5\xe8\xcb\xda\xbd\xa2\xd6\xe9\x62\x82\xda\x4d\x56\x9f\xd1\x5b\x26\x9b\xb3\xdf\xe8\x28\xf8\x26\x80\x2c\x21\x11\x5d\x88\x24\xa6\xd2\x52\x43\x9e\xd1\xc8\x47\xb6\x22\xc3\x35\x48\x12\x28\xdb\x8e\x81\x86\xf3\xd0\x0c\x96\xd2\xf4\x58\xf3\x7a\xf7\x37\xf6\x6d\xb0\x2f\x28\x4a\xe3\xfd\x31\xe1\x55\x03\x3b\x8d\x76\x4c\x31\x93\x14\xaf\xf9\x2c\x41\x48\xbc\x6f\x3d\xc5\x83\x87\x12\xa0\x7b\xba\x54\x78\x27\xb7\xd4\x33\x29\x52\x57\x96\x61\xca\xd4\x55\xf3\x1a\xf2\xa6\x85\xd6\x2e\x4a\xa3\xef\x31\x5b\xc6\x04\x77\xcb\xb5\xa4\xd4\xf3\x73\xb5\x22\x12\x9e\xf3\x25\x6a\x2d\x2f\xaf\xfc\x1e\xbc\x68\xc1\x6a\x78\x24\x49\x76\xda\x87\xde\x10\xe0\x3c\xa9\x95\xcb\x01\x36\xaf\xa0\xad\x60\x4d\x75\xfa\xe5\x60\x45\xb6\x1f\x56\x91\xbd\x11\xd4\x2f\x42\xf9\xc4\xe2\xcb\xc0\x6a\xcf\xb7\x0f\x5a\xcd\xff\x8d\xe0\xbe\x12\xab\xd4\x5b\xc2\x3e\x60\xcd\xe6\xf1\x07\xdb\xc1\x2c\x29\xf2\x45\x6f\x0b\x5c\x1f\xb1\xda\x05\x9d\x2f\x79\x64\x0a\x56\xfa\xc8\xa0\x84\xbe\x0d\x1e\x8c\x3f\x21\xa3\xcd\x93\x79\x55\x4c\x9c\x2b\x12\xdd\xe7\xe1\x6f\x2c\xf3\xc3\xcf\x85\x14\x85\xc2\x83\x8f\x69\x44\x6f\xee\x2f\xdb\xec\x11\x17\xc7\xe2\x91\xe3\xf7\xa9\xca\xd8\x78\xa2\xf9\xac\xc5\xc6\xed\xd2\x5f\x4b\x1a\x6d\xf3\xe6\xd9\x46\xc7\xf9\xf5\xba\x33\xcf\x80\x54\x84\xac\x9f\xdd\xb4\xec\x65\x37\x02\x71\x21\xcd\x6d\xb8\xee\xd9\x49\x7a\x74\x8c\x37\x79\x08\xe8\x33\xb8\x98\x41\x4c\x96\xd0\x3d\xfd\x6a\x70\x76\x72\x84\xce\x15\xdb\x38\xdc\x7c\xba\x80\xb3\xb3\xb3\xbf\x6a\xaa\x60\x6f\xe8\xd5\xb0\x76\x37\x76\xf4\x66\x35\xf0\xfa\xc5\x16\xf4\xa7\x8b\x76\xf0\xef\x07\x27\x7b\x83\xdf\x6e\xd6\xee\x42\x57\xb0\xc3\xe6\xc6\xee\xba\xd8\xc6\x25\xe8\xce\x7d\x2d\xf5\x94\x4d\x59\xc4\x4d\x72\x9d\xb1\x0a\xa0\xa6\x58\x4f\xdf\x35\xe4\x2a\x69\x24\x64\x5c\x4d\x9c\x63\x7f\x14\x96\x3b\xfe\x18\x0a\x1a\x9b\x44\x72\xcf\x3c\x06\x7b\xc3\xc9\xb3\x6d\x7b\xfb\x59\x53\xcd\x86\xda\x42\x31\xb7\x17\x31\xc4\xc0\x0b\xaa\x3a\xc6\x78\x87\xf1\x94\x7d\xaf\x1e\x59\x44\x81\xe4\x30\x23\xb9\x5a\x47\xb4\x5d\x77\x86\xc7\x4e\xcd\xdd\x68\xb2\xfd\x5d\x67\x3d\x54\x68\x14\xd7\x36\x7c\xfb\xc9\xdd\x39\x78\xed\x77\x9a\xfc\x57\xf9\x6e\xf1\x96\xa0\xd2\xe9\xd3\x9c\x4a\x4c\xff\x55\xce\xd6\x1b\xeb\xd4\xfb\x54\xaa\x3d\xe9\x7a\x7d\x7a\x2d\xf4\x6e\xa9\x51\x1b\x60\xcd\x2a\x35\xc0\xc6\x92\xf4\x26\xaa\x66\x69\x74\x07\x5d\xb5\xf4\xb9\xf1\x2c\xd1\xa8\x7a\xae\xd7\x3d\xab\x77\x16\xcc\x4c\x5a\x92\x02\x1b\x8b\x9e\x76\x27\xde\xb7\x86\x59\xe6\x05\xed\x89\xac\x19\x7c\x3a\x92\xc6\xab\xb7\xa8\xac\xb4\x3a\x9a\x17\x14\x2c\xde\x30\xb3\xfe\x6f\x2c\x59\xec\x2d\x60\x9f\x34\xdf\x9c\x98\xac\x09\x4b\xe7\xfa\xb6\x08\x6b\xfb\xb9\x7d\x63\x96\x6b\x53\xd4\xf0\xb2\x99\xbc\x20\x61\xb5\xd3\x97\xea\xdb\xc9\xea\xad\x93\x56\xbf\x2f\xb1\xd1\x8a\xe9\x0d\x52\x57\x7b\x0a\xbe\xee\x62\xda\x7b\x6e\xff\x02\xee\x57\xdb\xf7\xd8\x7a\x0a\xc3\xcc\xb6\xc7\xda\x76\x5a\x9d\xc6\x68\xee\xfb\xa6\x03\x5c\x7e\x5c\x1f\x65\x2f\xb9\xee\x9b\xa1\x70\x9e\xbf\x4d\xa2\x4d\xa9\xad\xb9\xe5\xaa\x1b\xae\xec\x98\xb5\x3d\xd3\x6d\x70\x55\x01\xef\x79\x0b\x37\x11\xf8\xdf\x7e\x3c\x54\x6e\xe2\x63\xbf\x9e\xf9\x2f\x2d\x5a\x76\x5b\xdd\xda\xb8\xcb\xa7\x2b\xf3\x28\xab\x51\xf0\x90\x0a\x8c\xf1\x82\xb1\xfd\xd0\xb8\x56\xd6\x12\x21\x55\xf9\xe2\x17\xfe\xa5\x48\xa0\xd4\x1b\x8b\x4b\x9e\x56\xcd\xfe\xb1\x72\x01\xce\x9d\xd1\x7e\x32\x6d\xda\x65\xd6\x54\x7d\xff\x30\x7a\x77\x2c\xc9\x4c\x8d\x4e\xdd\xa4\x2a\x51\x41\x65\x7e\xfe\x7e\xdd\xf8\x60\x9f\x4b\x78\x06\x92\x2d\x9e\x79\x48\xe6\x76\x2a\xd8\xd7\xee\x5e\xb0\x09\xd2\x6b\x12\xa9\x82\xb0\x26\x67\x46\xc8\x8b\x69\xca\xd4\x8e\x5d\x23\x18\x4f\xa8\x82\x44\xcc\x41\x6b\xb0\x6a\x60\xce\x38\x86\xfd\x98\x3d\x8c\x0f\xfe\x7f\x00\x35\xb2\x1e\xcc\x64\x47\x00\x00")
 
 func assetsTemplatesClusterHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -110,12 +159,32 @@ func assetsTemplatesClusterHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/cluster.html", size: 3696, mode: os.FileMode(420), modTime: time.Unix(1487262520, 0)}
+	info := bindataFileInfo{name: "assets/templates/cluster.html", size: 18276, mode: os.FileMode(420), modTime: time.Unix(1792168210, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesErrorHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x54\x4d\x6f\xdb\x38\x10\x3d\xaf\x7f\xc5\x84\x7b\x5d\x8a\x70\xf6\xb2\x07\x4a\xc0\xb6\xc8\xa1\xf7\x16\xe8\x75\x44\x8e\x24\xba\x14\xa9\x90\x23\x25\x46\x90\xff\x5e\xd0\x92\x1d\x27\x48\x81\xa0\xe8\xc1\xa0\x1f\x39\xf3\xe6\xeb\x8d\xf4\x8d\x8d\x86\x8f\x13\xc1\xc0\xa3\x6f\x76\xba\x1c\xe0\x31\xf4\xb5\xa0\x20\x9a\x1d\x80\x1e\x08\x6d\xf9\x03\xa0\x47\x62\x04\x33\x60\xca\xc4\xb5\x98\xb9\x93\xff\x89\xeb\xa7\x81\x79\x92\x74\x3f\xbb\xa5\x16\xdf\xe5\xb7\xff\xe5\xe7\x38\x4e\xc8\xae\xf5\x24\xc0\xc4\xc0\x14\xb8\x16\x5f\xee\x6a\xb2\x3d\xbd\xf2\x0c\x38\x52\x2d\x16\x47\x0f\x53\x4c\x7c\x65\xfc\xe0\x2c\x0f\xb5\xa5\xc5\x19\x92\x27\xf0\x0f\xb8\xe0\xd8\xa1\x97\xd9\xa0\xa7\x7a\x2f\x9a\xdd\xca\xc4\x8e\x3d\x35\x96\xc6\x08\x94\x52\x4c\x5a\xad\x37\xdb\xb3\x77\xe1\x07\x24\xf2\xb5\xc8\x7c\xf4\x94\x07\x22\x16\x30\x24\xea\x6a\xa1\x94\xb1\xe1\x90\x2b\xe3\xe3\x6c\x3b\x8f\x89\x2a\x13\x47\x85\x07\x7c\x54\xde\xb5\x59\xf1\x83\x63\xa6\x24\xdb\x18\x39\x73\xc2\x49\xfd\x5b\xed\xab\xbd\x32\x39\xab\xcb\x5d\x65\x72\xbe\x64\x93\x4d\x72\x13\x43\x4e\xe6\x03\xf4\x87\xfb\x99\xd2\x51\xdd\x9e\x38\x57\x50\x8d\x2e\x54\x87\x2c\x1a\xad\x56\xaa\xe6\x37\x78\x7f\x95\xf6\xe1\x3a\xeb\xd7\x41\x3e\xd0\xac\x52\xb4\xa5\x0e\x67\xcf\x5b\xc9\x00\x5a\x9d\x85\xa2\xdb\x68\x8f\x5b\xb2\x01\x17\x30\x1e\x73\xae\x45\xc0\xa5\xc5\x04\xeb\x21\x37\xf7\x33\xec\xdc\x23\x59\xc9\x71\x12\x90\xa2\xa7\x93\xb5\xeb\x91\x5d\x0c\x9b\x4e\x00\xb4\x75\x17\xb2\xa2\x0f\x74\x81\x92\xec\xfc\xec\xac\x68\x76\x7f\xe9\x1b\x29\xe1\x53\xc2\x60\xa1\xfc\x38\xf6\xbd\x27\xe8\x89\xa1\x4f\x71\x9e\xc8\x42\x17\x13\xb4\x54\xfa\x01\x63\x6c\x9d\x27\xb0\x2e\x4f\x1e\x8f\x20\x65\x21\xb8\xe2\xdf\xd2\x2a\x25\x51\x2a\xec\xa5\xac\x99\x39\x06\x28\xeb\x52\x8b\x15\x88\x37\xf6\x6b\x50\x01\x16\x19\x37\x50\x72\xf5\x1e\xa7\x7c\xb9\xc6\xd4\x97\xf5\xf9\xbb\xcd\x92\x1e\x71\x9c\x3c\xc9\xcd\xfd\x6c\x29\xf7\x6b\xc8\x32\xed\x09\xc3\x39\x48\x4e\x32\x06\x7f\x14\xcd\xd7\xb5\xb6\x97\x1e\x69\x55\xec\xde\xf3\x71\x26\x06\xd9\x62\x3a\x4d\xf8\x4f\xdb\x68\xb5\xb6\x61\x05\xf8\xa6\x19\x6d\x99\xc5\x45\x33\xe2\xb4\x98\x5a\x61\xe9\xb4\xb2\x6e\xb9\x8c\xf5\x05\x68\x15\x70\x39\x2b\xf0\xbd\x69\xbf\x68\x61\xb8\x6d\x9e\x9e\xaa\xbb\xb2\xe6\xcf\xcf\x5a\x0d\xb7\x67\x86\x8d\x4c\xab\x55\x85\x5a\xad\x5f\xb6\x9f\x01\x00\x00\xff\xff\xe4\xaf\x29\x5d\xea\x04\x00\x00")
+var _assetsTemplatesConfirmHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x91\xc1\x6a\xe3\x30\x10\x86\xef\x79\x8a\x41\xf7\x8d\x21\xc7\x45\x16\xec\xe6\x5e\x7a\x28\xed\x79\x6c\x8d\x63\x11\x7b\x64\xa4\x71\x68\x31\x7a\xf7\x22\xc5\x49\x1c\x4a\xaf\xa3\xff\xfb\xf4\x6b\xa4\xad\xbb\x40\x3b\x60\x8c\xb5\x6a\x3d\x0b\x3a\xa6\xa0\xcc\x0e\x40\xf7\x07\x73\xf4\xdc\xb9\x30\xc2\xb2\xc0\xfe\x5f\x2b\xce\x33\xa4\xa4\xab\xfe\x50\x02\xd3\x0d\x14\xfa\x94\x3f\x16\xf9\x94\xd1\x9c\xfd\xc0\xc0\x8e\x4f\x25\x3c\x95\x6c\xe7\xc3\x08\x23\x49\xef\x6d\xad\x26\x1f\x45\x01\x16\x61\xad\x96\x05\x1a\x8c\x04\x29\x65\xf4\x15\xa5\x87\x94\x4a\x05\xc8\x17\x87\xec\x85\xfd\x3b\x0e\x33\x45\x48\xa9\xcc\xf5\x64\x74\x94\xe0\xf9\x64\x32\xf4\x82\x63\xe6\xff\xea\x6a\x1d\x82\x6e\xbd\xa5\x72\x56\xc0\xd2\xa4\x8c\xd6\x42\x00\xda\xf1\x34\x0b\xc8\xd7\x44\xb5\xea\x9d\xb5\xc4\x0a\x18\x47\xaa\xd5\x46\xa9\xe0\x92\xf9\x5a\x6d\x55\x8f\x72\xc4\xf6\x5e\xe9\x77\x5f\x7b\x5d\xe3\x93\xeb\xcd\x9f\x89\x1f\x2e\xdd\xcc\x22\x9e\x57\x3c\xce\xcd\xe8\x44\xdd\xf6\xdb\x08\x43\x23\xfc\xb4\xe2\xcd\x77\x5c\xd1\x7b\x27\xd7\xc1\xfe\x3f\xb6\xe7\x7c\x86\x3f\x14\xd4\xe1\x3c\x88\x82\x3e\x50\x77\x7d\xd5\x9a\x55\xe6\x88\xdc\xd2\xa0\x2b\x34\xdb\x97\xe9\x2a\xff\x9d\xd9\xe9\xca\xba\x8b\xd9\x7d\x0f\x00\xcb\xe0\x91\x9d\x31\x02\x00\x00")
+
+func assetsTemplatesConfirmHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesConfirmHtml,
+		"assets/templates/confirm.html",
+	)
+}
+
+func assetsTemplatesConfirmHtml() (*asset, error) {
+	bytes, err := assetsTemplatesConfirmHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/confirm.html", size: 561, mode: os.FileMode(420), modTime: time.Unix(1792165838, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesErrorHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x54\x3d\x6f\xf3\x36\x10\x9e\xe3\x5f\x71\x61\xd7\x52\x44\xd2\xa5\x03\x25\xa0\x2d\x32\x74\xeb\x90\x02\x5d\x4f\xe4\x49\xa2\x4b\x91\x0a\x79\x52\x6c\x18\xfe\xef\x05\x2d\x7f\x25\x48\xd1\xe2\x05\xde\xc1\x10\x8f\x7c\xee\xb9\xaf\xe7\xac\x1f\x6d\x34\xbc\x9f\x08\x06\x1e\x7d\xb3\xd1\xe5\x03\x1e\x43\x5f\x0b\x0a\xa2\xd9\x00\xe8\x81\xd0\x96\x03\x80\x1e\x89\x11\xcc\x80\x29\x13\xd7\x62\xe6\x4e\xfe\x2c\xee\x9f\x06\xe6\x49\xd2\xdb\xec\x96\x5a\xfc\x25\xff\xfc\x45\xfe\x16\xc7\x09\xd9\xb5\x9e\x04\x98\x18\x98\x02\xd7\xe2\xf7\x97\x9a\x6c\x4f\x1f\x3c\x03\x8e\x54\x8b\xc5\xd1\xfb\x14\x13\xdf\x81\xdf\x9d\xe5\xa1\xb6\xb4\x38\x43\xf2\x64\xfc\x08\x2e\x38\x76\xe8\x65\x36\xe8\xa9\x7e\x12\xcd\x66\x65\x62\xc7\x9e\x9a\xc3\x01\xaa\xd7\x72\xfa\x23\x51\xe7\x76\x70\x3c\x5a\x1a\x23\x50\x4a\x31\x69\xb5\x62\x56\xbc\x77\xe1\x6f\x48\xe4\x6b\xe1\x4c\x0c\x02\x86\x44\x5d\x2d\x0e\x07\x68\x31\x13\x1c\x8f\xaa\xc3\xa5\xbc\x54\xce\xc4\x6b\x90\x9b\x53\xe6\xbd\xa7\x3c\x10\xf1\xc5\x55\x29\x63\xc3\x36\x57\xc6\xc7\xd9\x76\x1e\x13\x55\x26\x8e\x0a\xb7\xb8\x53\xde\xb5\x59\xf1\xbb\x63\xa6\x24\xdb\x18\x39\x73\xc2\x49\xfd\x54\x3d\x55\x4f\xca\xe4\xac\xae\x77\x95\xc9\xf9\x1a\x2e\x9b\xe4\x26\x86\x9c\xcc\xff\xa0\xdf\xbe\xcd\x94\xf6\xea\xf9\xc4\xb9\x1a\xd5\xe8\x42\xb5\xcd\xa2\xd1\x6a\xa5\x6a\xbe\x81\xf7\xdf\xd2\xde\xde\x67\xfd\x31\xc8\x7f\x37\xeb\xae\xcf\xa5\x7c\x4b\x1d\xce\x9e\xcf\xc5\x03\x68\x75\x11\x9e\x6e\xa3\xdd\x9f\xd3\x0e\xb8\x80\xf1\x98\x73\x2d\x02\x2e\x2d\x26\x58\x3f\xf2\xec\x7e\x31\x3b\xb7\x23\x2b\x39\x4e\x02\x52\xf4\x74\x42\xbb\x1e\xd9\xc5\x70\xd6\x1d\x80\xb6\xee\x4a\x56\xf4\x86\x2e\x50\x92\x9d\x9f\x9d\x15\xcd\xe6\x41\x3f\x4a\x09\xbf\x26\x0c\x16\xca\x8f\x63\xdf\x7b\x82\x9e\x18\xfa\x14\xe7\x89\x2c\x74\x31\x41\x4b\x65\xa0\x30\xc6\xd6\x79\x02\xeb\xf2\xe4\x71\x0f\x52\x16\x82\x3b\xfe\x73\x5a\xa5\x24\x4a\x85\xbd\x94\x35\x33\xc7\x00\x65\xfd\x6a\xb1\x1a\xe2\x13\x7e\x0d\x2a\xc0\x22\xe3\xd9\xa8\x85\x89\xde\xe3\x94\xaf\xd7\x98\xfa\xb2\x8e\x3f\xb4\x59\xd2\x0e\xc7\xc9\x93\x3c\xbb\x5f\x90\xb2\xec\xc8\xc3\xa9\xe6\x3c\x61\xb8\x04\xc9\x49\xc6\xe0\xf7\xa2\x79\x3d\x31\xc3\xad\x47\x5a\x15\xdc\x57\x3e\x65\x1f\x64\x8b\x49\x34\xdf\x01\xa3\xd5\xda\x86\xd5\xc0\x4f\xcd\x68\xcb\x2c\xbe\x50\x8f\x68\xca\x82\x6b\x85\xa5\xe7\xca\xba\xe5\x3a\xe0\x9b\xa1\x55\xc0\xe5\xa2\xca\xaf\xe6\x7e\x53\xc5\xf0\xdc\x1c\x0e\xd5\x4b\xf9\xbb\x38\x1e\xb5\x1a\x9e\x9b\xcd\x07\x32\xad\x56\x3d\x6a\x35\xf0\xe8\x9b\xcd\x3f\x03\x00\x6e\xc5\x73\x2b\x44\x05\x00\x00")
 
 func assetsTemplatesErrorHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -130,12 +199,52 @@ func assetsTemplatesErrorHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/error.html", size: 1258, mode: os.FileMode(420), modTime: time.Unix(1467317820, 0)}
+	info := bindataFileInfo{name: "assets/templates/error.html", size: 1348, mode: os.FileMode(420), modTime: time.Unix(1792162013, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesEventsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x91\x4f\x4f\x33\x21\x10\xc6\xef\xfb\x29\x26\xbc\xe7\x77\x31\x35\xf5\xa0\xb3\xdc\x3c\xda\x93\x5f\x80\xdd\x99\x16\xe2\x0a\x0d\x60\xd5\x10\xbe\xbb\x81\xfe\xd1\x56\x9b\x4d\x36\x3c\xcc\x6f\x9e\x67\xc8\x60\x4c\x9f\x33\xab\x0e\x20\x19\xc8\x1d\x00\xc0\xa8\xa7\x97\x4d\xf0\x6f\x8e\xee\xe1\xdf\x7a\x59\xbf\x87\x0e\xa0\x74\x28\x0f\x30\x92\xdd\xc1\x34\xeb\x18\x07\x31\x79\x97\xb4\x75\x1c\x44\x35\x41\xb3\x50\x8f\x3b\x76\x29\xa2\x34\x8b\x76\x93\xf4\x38\xf3\x91\xde\x8b\xf6\xff\x3f\xfa\x40\x1c\x98\x0e\x72\xf2\x8e\xd8\x45\xa6\x66\x54\x1b\xc3\xfe\x50\x8f\x06\xde\x2d\x25\x33\x88\xc5\xf2\x66\xfb\x21\xd4\xb3\x7d\x65\x94\xc9\xfc\x41\xdc\x35\x60\xe5\xe9\x12\xd8\x0f\xf6\x7d\x89\xf2\x98\x90\x33\x04\xed\x36\x0c\x7d\x43\x22\x94\x72\x6a\x3b\x0d\x51\x43\x48\xe5\x0c\x7d\x0d\x87\x52\x50\x26\xfa\x55\xb4\x6b\xe8\x6b\x76\xad\x6b\x30\x81\xd7\x83\xc8\x19\x46\x1d\x19\x4a\x91\xce\x13\xcb\x9c\x4f\x8c\x50\x3f\x04\x4a\x5d\x25\x3b\xba\xe6\xde\x3f\x71\x8c\x7a\x73\x99\x7e\xf6\x12\x9e\x5b\xd4\xb1\x94\xc2\x99\x0b\x4c\x7e\x8e\x5b\xed\x06\x71\x2b\x14\x5a\xb5\xf2\x8e\x51\x5a\x75\xdd\xae\x4d\x53\x17\x29\xdb\x9e\x54\x87\x92\xec\x4e\x75\x5f\x03\x00\xc7\xf5\x6a\xa4\x3a\x02\x00\x00")
+
+func assetsTemplatesEventsHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesEventsHtml,
+		"assets/templates/events.html",
+	)
+}
+
+func assetsTemplatesEventsHtml() (*asset, error) {
+	bytes, err := assetsTemplatesEventsHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/events.html", size: 570, mode: os.FileMode(420), modTime: time.Unix(1792161709, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesFollowHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x54\x4d\x6f\xe3\x46\x0c\xbd\xeb\x57\x70\x67\x03\x44\x46\x6a\x69\xbb\x47\x5b\xf2\x02\x45\x51\xb4\x97\xa0\x48\x02\x14\x3d\x8e\x34\xb4\x24\x64\x34\x14\x66\xa8\x28\x86\xe1\xff\x5e\xcc\xe8\xcb\x89\x8b\x5e\x7a\x92\xc5\x47\xbe\x47\x3e\xd1\xcc\x1c\x9f\x34\x1e\x22\x80\xa4\x24\xc3\xb2\x31\x68\xe1\x1c\x01\x0c\x8d\xe2\x7a\x07\xb2\x67\xda\x47\x00\x97\x08\xa0\xb3\x18\xa0\x42\x96\xaf\x95\xa5\xde\xa8\x1d\x18\x32\xe8\xf1\x82\xac\x42\xbb\xbe\x5f\xa2\x2c\x9d\xa8\x33\x57\xda\xa6\x63\xaf\x71\x17\x1f\x7b\x53\x72\x43\x26\xde\x04\x2a\x80\x37\x69\x41\x53\x05\x39\xdc\xc5\xf7\x5f\x35\x55\xf7\x9b\xfd\x02\x1c\x49\x6b\x1a\x20\x07\xb6\x3d\xae\x61\xa6\xaa\xd2\x38\x95\x8c\x39\xbe\x2a\xe0\xb3\x00\x38\xe4\xdf\x02\x14\x1f\x67\x2d\x58\x09\x8f\xfb\x29\x32\x72\x25\x8c\xef\x1c\x4f\xe8\x0f\x10\x7f\xca\xde\x61\x18\x7e\xeb\x4a\x4b\x5a\x0b\xd8\x81\x78\x42\xd7\xb7\x1f\xc3\x53\xb3\x00\xcd\x11\xa6\xfa\x55\xcd\xbb\x68\x14\x0d\xc9\xc8\xf1\x42\xf1\xb7\x9f\x40\x51\xd9\xb7\x68\x38\x29\x48\x9d\x26\xe4\x77\x6c\xaa\x9a\x17\x2e\x6f\xb6\xb7\x30\xba\x6a\xb0\xd4\x4d\xf9\x7a\x6b\x1f\x5c\xcd\xf9\x65\xd2\x1f\x69\x2e\xb3\x23\x69\x0a\xcf\x41\xa5\x31\x15\xf4\x1d\x38\xa6\xce\x4d\x4e\x34\xa6\xda\x83\x5b\x50\xff\x65\x81\x09\xb8\x46\x28\x88\x99\x5a\xb0\x61\x66\x97\x04\xce\xbb\x78\x1c\x68\x33\xf5\xfd\x6f\xfd\xf8\xef\x26\xf9\x97\xb1\x3a\x9f\x1d\x68\x8c\x41\x3b\x8e\x09\x0f\x1f\x6d\xf9\x1b\x0e\xf9\x7f\xb8\x02\x5b\xf8\xf9\xdb\xad\xcb\xf0\x25\x5f\x64\x56\xf1\x6b\x3b\x16\xf4\x93\xad\xb3\x2f\xbe\x51\x57\xd6\xd8\xe2\xda\xa6\xa6\x52\xfa\xed\x49\x3a\x4b\x4c\x25\x69\xc8\x73\x10\x35\x73\xe7\x76\x02\x7e\x80\x18\x9c\xdb\xa5\x69\xd8\x86\x21\xfc\x5a\xb7\x72\x70\x90\x83\xc1\x01\xfe\xc2\xe2\x99\xca\x57\xe4\x78\xa2\x7f\xb8\xa1\xaf\xc9\x31\x3c\x4c\x7d\x89\xf3\x19\x0a\xe9\x10\x2e\x97\xd4\x90\xc2\xf4\x7c\x86\xe4\x91\x14\x26\x8f\xb2\x0d\x51\xdb\x9b\x25\xf8\xd4\x9b\xe4\x8f\x5f\x7d\x74\x70\x5b\x4d\x55\x00\x5e\x4e\x9d\x4f\x9c\xd7\x71\x70\x09\x99\x16\x9d\x93\x95\x1f\x6e\xf9\x4a\xb8\x3a\xa5\xa9\x4a\x64\xd7\xa1\x51\xf1\xe2\x7d\x69\x51\x32\xbe\xe0\x3b\x7b\xf5\x18\x13\x25\x59\x6e\x36\xb7\xe6\x5f\x1b\xfe\xbf\x56\xfc\xaa\xdd\x52\x93\xfb\xd0\xec\x2a\xe2\x2f\x83\x63\xc9\xbd\xbb\xdf\x8c\xff\x54\xe1\xd8\xa2\x6c\x21\x14\xa9\x79\xec\x8b\x7f\xf8\xbd\xcf\xd2\xf9\xe6\x64\xaa\x79\x83\x52\x4b\xe7\x72\xb1\x1c\x38\xe1\x6f\x51\x56\x7f\x3f\x7c\x36\x1a\xbe\xde\xb8\x0c\x5b\xb8\x32\x38\x4b\xeb\xef\xa1\xb8\xe8\x99\xc9\x00\x9f\x3a\xcc\xc5\xf8\x22\xa0\x51\xb9\x18\xb7\x53\xcc\x9a\x05\x1b\x28\xd8\x6c\xdf\x5d\x78\x28\x3c\xca\x5e\xb3\x38\xdc\x1c\x98\x2c\x1d\x59\x02\xbb\xeb\xa4\x09\x6c\xe3\xd4\x0b\x9b\x9f\x7d\xdb\xf6\x8c\x4a\x1c\xb2\xd4\x67\x85\x74\x7f\x98\x7d\xb6\xa6\xca\xc7\x3b\x8b\x87\x28\x4b\x55\xf3\x76\x88\xfe\x19\x00\x33\x3b\x46\x00\xe0\x05\x00\x00")
+
+func assetsTemplatesFollowHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesFollowHtml,
+		"assets/templates/follow.html",
+	)
+}
+
+func assetsTemplatesFollowHtml() (*asset, error) {
+	bytes, err := assetsTemplatesFollowHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/follow.html", size: 1504, mode: os.FileMode(420), modTime: time.Unix(1792161709, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesLayoutHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x56\x4d\x8f\xe4\x34\x10\x3d\x33\xbf\xa2\xd6\x7b\x1d\xc7\x1a\xb8\x70\x70\x22\xc1\x80\xc4\x5e\x16\xb4\x1a\x24\xae\x95\xb8\x3a\xf1\xe0\xd8\x19\xbb\xd2\x33\xad\x28\xff\x1d\xb9\x93\xfe\x5c\x58\x5a\xb0\x87\x51\x52\x4e\xf9\xbd\x7a\xaf\xca\x9e\xd6\xef\x4c\x68\x78\x37\x10\x74\xdc\xbb\xea\x4e\xe7\x07\x38\xf4\x6d\x29\xc8\x8b\xea\x0e\x40\x77\x84\x26\xbf\x00\xe8\x9e\x18\xa1\xe9\x30\x26\xe2\x52\x8c\xbc\x91\xdf\x8b\xf3\x4f\x1d\xf3\x20\xe9\x65\xb4\xdb\x52\xfc\x21\x7f\xff\x41\x3e\x86\x7e\x40\xb6\xb5\x23\x01\x4d\xf0\x4c\x9e\x4b\xf1\xe1\xe7\x92\x4c\x4b\x17\x3b\x3d\xf6\x54\x8a\xad\xa5\xd7\x21\x44\x3e\x4b\x7e\xb5\x86\xbb\xd2\xd0\xd6\x36\x24\xf7\xc1\x3d\x58\x6f\xd9\xa2\x93\xa9\x41\x47\xe5\x83\xa8\xee\x16\x24\xb6\xec\xa8\x9a\xa6\xe2\x29\xbf\xcc\xb3\x56\xcb\xca\xfa\xd9\x59\xff\x27\x44\x72\xa5\x48\xbc\x73\x94\x3a\x22\x16\xd0\x45\xda\x94\x42\xa9\xc6\xf8\xe7\x54\x34\x2e\x8c\x66\xe3\x30\x52\xd1\x84\x5e\xe1\x33\xbe\x29\x67\xeb\xa4\xf8\xd5\x32\x53\x94\x75\x08\x9c\x38\xe2\xa0\xbe\x2b\x1e\x8a\x07\xd5\xa4\xa4\x8e\x6b\x45\x93\xd2\xb1\x9a\xd4\x44\x3b\x30\xa4\xd8\xdc\x00\xff\xfc\x32\x52\xdc\xa9\x6f\xf7\x98\x4b\x50\xf4\xd6\x17\xcf\x49\x54\x5a\x2d\x50\xd5\x7f\xc0\xfd\xa7\xb2\x9f\xcf\xab\xbe\x24\xb9\xc1\xac\x2c\xda\xd0\x06\x47\xc7\xab\x64\x00\xad\x0e\x83\xa2\xeb\x60\x76\x6b\xb1\x1e\xb7\xd0\x38\x4c\xa9\x14\x1e\xb7\x35\x46\x58\x1e\x72\xdd\x7e\x08\x37\xf6\x8d\x8c\xe4\x30\x08\x88\xc1\xd1\x3e\xdb\xb6\xc8\x36\xf8\x75\x4e\x00\xb4\xb1\x47\xb0\x3c\x1f\x68\x3d\x45\xb9\x71\xa3\x35\xa2\xba\xfb\x46\xbf\x93\x12\x7e\x8c\xe8\x0d\xe4\x3f\x0e\x6d\xeb\x08\x5a\x62\x68\x63\x18\x07\x32\xb0\x09\x11\x6a\xca\x7e\x40\x1f\x6a\xeb\x08\x8c\x4d\x83\xc3\x1d\x48\x99\x01\xce\xf0\xd7\xb2\xb2\x24\x8a\x19\x3d\xcb\x1a\x99\x83\x87\x7c\x5c\x4a\xb1\x04\xe2\x2a\x7f\x21\x15\x60\x90\x71\x0d\x72\xad\xce\xe1\x90\x8e\xcb\x18\xdb\x7c\x7c\xde\xd7\x49\xd2\x1b\xf6\x83\x23\xb9\x6e\x3f\x64\xca\x87\x85\x32\x77\x7b\x40\x7f\x20\x49\x51\x06\xef\x76\xa2\x7a\x5a\xb4\x9d\x3c\xd2\x2a\xe7\xfd\xdd\x1e\xdb\x04\x2f\x6b\x8c\xfb\x0e\x7f\xed\x1c\xad\x16\x1b\x96\x00\xaf\xcc\xa8\x73\x2f\x8e\x33\x23\x2a\x43\x7d\xd0\x0a\xb3\xd3\xca\xd8\x6d\x75\xb7\xf6\xec\x31\x38\x47\x0d\x03\x77\x7b\x49\x90\x47\x2f\xdd\xe7\x6e\xf5\xe9\x7e\xdf\xcb\xc0\x1d\xc5\xc3\x9d\xb0\x6f\xe3\xde\x5b\xeb\xdb\xcf\x3b\x77\xf0\x10\xae\x3c\x15\x60\x4d\x29\xfe\xdd\x73\x3d\xba\x33\x1d\x07\x14\x8f\xdb\x43\x4b\xa6\x09\xec\x06\x8a\x47\x37\xa6\x3c\x49\xf3\xbc\xba\xe5\xec\xf2\x85\x5e\xa0\xf8\x0d\x5b\x02\xf1\x31\x18\x4a\x02\xe6\xf9\x00\x88\x0d\xdb\x2d\x89\x69\x22\x6f\xe6\xb9\xd2\x78\x32\xa7\x59\xe0\xb2\x3f\x5a\x39\x7b\xe2\x22\x6f\x8e\x1c\x2b\x75\xc6\x3d\xe7\x85\x6b\xe2\x5f\x6c\xe2\x10\x77\x99\xfa\x9a\x79\xc5\x3b\xe3\xf6\xc1\x90\x9a\xa6\x05\xb6\xf8\x88\x7d\xc6\x16\xd5\x45\xf7\x5b\xb7\x1b\xba\x3c\x02\x70\x7c\x93\x06\x53\x57\x07\x8c\xe6\x38\x12\x70\x8d\x72\xb3\x9a\x4f\xa3\xff\xa2\xa0\x35\xe7\x7f\x08\x52\x71\xf4\xc7\xc5\x4f\xa3\x2f\x3e\xfc\x74\x9b\xcc\x7c\x3f\x9c\x14\xe6\x42\xdf\x7f\x06\x73\x8b\xce\x4b\x31\xbf\x8e\x3c\x8c\x2c\x2e\x44\x5f\x2a\x3b\x09\xba\xa1\xc8\x8d\x75\x74\xd9\x86\xa7\xfc\x4f\xfd\xcb\x95\x69\x35\xba\xd3\x61\x5c\xef\xd8\x53\xa0\x95\xc7\xf5\x75\x9a\x8a\xc7\xe5\xf0\xcd\xf3\xfe\xaa\x5f\x6e\x78\xad\x96\x5f\x0d\x7f\x05\x00\x00\xff\xff\xce\x2e\x8e\x1e\x46\x08\x00\x00")
+var _assetsTemplatesLayoutHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x57\xdf\x8f\xd4\x38\x12\x7e\x66\xfe\x8a\xc2\x9c\x34\x20\x48\xcc\x1c\xba\xd3\x49\x24\x91\xb8\x39\xa4\x43\x3a\x71\x23\x60\xa5\x7d\x75\xc7\x95\xc4\x83\x63\x07\xbb\xd2\x4c\xab\xc9\xff\xbe\x72\x9c\x74\x32\x3f\x60\x7a\x56\xbb\x62\x1f\x5a\x1d\xdb\xf5\x95\xbf\xaf\xaa\xe2\x94\xb3\xc7\xd2\x96\xb4\xeb\x10\x1a\x6a\x75\x71\x92\x85\x3f\xd0\xc2\xd4\x39\x43\xc3\x8a\x13\x80\xac\x41\x21\xc3\x03\x40\xd6\x22\x09\x28\x1b\xe1\x3c\x52\xce\x7a\xaa\x92\x7f\xb1\xf5\x52\x43\xd4\x25\xf8\xa5\x57\xdb\x9c\xfd\x9a\xfc\xf2\x26\x39\xb7\x6d\x27\x48\x6d\x34\x32\x28\xad\x21\x34\x94\xb3\x77\x6f\x73\x94\x35\x5e\x43\x1a\xd1\x62\xce\xb6\x0a\xbf\x76\xd6\xd1\xca\xf8\xab\x92\xd4\xe4\x12\xb7\xaa\xc4\x64\x1c\xbc\x00\x65\x14\x29\xa1\x13\x5f\x0a\x8d\xf9\x19\x2b\x4e\xa2\x27\x52\xa4\xb1\xd8\xef\x21\xfd\x14\x9e\x2e\x1c\x56\xea\x0a\x86\x61\xbf\x8f\x13\xc3\x90\xf1\x68\x33\xda\xef\xf7\xa0\x2a\x10\x46\x42\xfa\x01\x2b\x87\xbe\x81\xf4\x4d\x4f\x76\x1e\x0c\xc3\x6d\x55\x2e\xae\xad\x08\xee\xf7\x0b\x7c\x18\x58\xd8\x1e\x8d\x84\x61\x18\xf7\xc8\xb4\x32\x9f\xc1\xa1\xce\x99\x2a\xad\x61\xd0\x38\xac\x72\xb6\xdf\xc3\x46\x78\x84\x61\xe0\x95\xd8\x86\x95\x54\x95\xf6\x20\x64\x01\x79\xda\x69\xf4\x0d\x22\xcd\x50\xce\x4b\x69\x2e\x7d\x5a\x6a\xdb\xcb\x4a\x0b\x87\x69\x69\x5b\x2e\x2e\xc5\x15\xd7\x6a\xe3\x39\x7d\x55\x44\xe8\x92\x8d\xb5\xe4\xc9\x89\x8e\xbf\x4a\xcf\xd2\x33\x5e\x7a\xcf\x0f\x73\x69\xe9\xfd\x61\x3b\x5f\x3a\xd5\x11\x78\x57\x1e\xe1\xfe\xf2\x4b\x8f\x6e\xc7\xff\x3e\xfa\x8c\x83\xb4\x55\x26\xbd\xf4\xac\xc8\x78\x74\x55\xfc\x0e\xbf\xdf\xa3\x7d\xb9\x66\x7d\x7d\x93\xfb\x83\xb5\x8a\x73\x90\x2f\xb1\x12\xbd\xa6\x49\xfc\x8a\x63\xb1\x15\x6e\xb4\xbc\x10\xd4\x40\x0e\x0b\xee\xf5\x0f\x34\xad\xbc\x5f\x7a\xde\x09\x8d\x44\x78\x2b\x10\x19\x9f\xdf\xa0\x6c\x63\xe5\x6e\xf2\x63\xc4\x16\x4a\x2d\xbc\xcf\x99\x11\xdb\x8d\x70\x10\xff\x92\x89\xe3\x3c\xac\xd4\x15\xca\x84\x6c\xc7\xc0\x59\x8d\xa3\xb5\xaa\x05\x29\x6b\x26\x09\x00\x99\x54\x07\x67\xa1\x2e\x85\x32\xe8\x92\x4a\xf7\x4a\xb2\xe2\xe4\x51\xf6\x38\x49\xe0\xdf\x2e\x14\x7a\xf8\x91\xad\x6b\x8d\x50\x23\x41\xed\x6c\xdf\xa1\x84\xca\x3a\xd8\x04\xf2\x0e\x5a\xbb\x51\x1a\x41\x2a\xdf\x69\xb1\x83\x24\x09\x0e\x56\xfe\x27\x5a\x41\x12\xba\xe0\x3d\xc8\xea\x89\xac\x81\x70\x8e\xe4\x2c\x0e\xd8\x0d\xfb\xb8\x29\x03\x29\x48\x4c\x83\x9c\x95\x56\x6b\xd1\xf9\xc3\xb4\x70\x75\x38\x57\x9e\x6c\x7c\x82\x57\xa2\xed\x34\x26\x13\x7c\xb6\x4c\xc2\xcb\xfe\x68\xd4\xec\x3b\x61\xe6\x4d\xbc\x4b\xac\xd1\x3b\x56\x7c\x1a\x3d\xc3\x12\xa3\x8c\x07\xbb\xbb\x30\xe1\xa5\x4b\x36\xc2\xb1\xe2\x4f\xb0\xc9\x78\x0c\x43\x1c\x88\x1b\xc1\xd8\x84\x5c\xdc\x51\xa2\xac\x90\xd8\xda\x8c\x8b\x08\xeb\x6e\xc0\x08\xaf\x68\x3c\x5f\xd2\xf7\xa2\x0d\x80\x8c\x77\x21\x3b\x5c\xaa\x6d\x71\x32\xe5\xf9\xdc\x6a\x8d\x25\x01\x35\x63\x18\x20\xbc\x1d\xfe\x45\xc8\x70\xeb\x5f\x8c\x87\x9d\xa5\x06\xdd\x7c\x7e\x85\x05\x18\xf3\xa1\x4c\x7d\x3b\xdb\x73\xdc\xe1\x46\x1e\x18\x28\x99\xb3\xfb\xf3\x94\xf5\x7a\x25\x62\xf6\x62\xc4\x76\x4e\x63\x3c\x83\xd3\x73\xdd\xfb\x50\x7d\xc3\x30\x45\x58\xab\xb8\x82\x5f\x20\xbd\x10\x35\x02\x7b\x6f\x25\x7a\x06\xc3\x30\x3b\x14\x25\xa9\x2d\xb2\xfd\x1e\x8d\x1c\x86\x22\x13\x77\x05\xb4\x8c\x8e\x43\x4c\x33\xae\xd5\xb2\xeb\x74\x46\xaf\x48\xdc\xda\x8a\x7d\x44\x5d\x9d\x37\x58\x7e\x66\xc0\xde\x6e\xd1\x50\x98\xbc\xb0\x6e\xfc\xff\x88\x44\xca\xd4\xe1\xf1\x7f\x28\x7c\xe4\xf6\x5d\xf6\x33\xfc\x41\xf4\x31\x82\x8a\x6b\xf5\x56\xeb\x5d\xd7\x84\xa2\x83\xc3\x53\xa2\x95\xa7\x43\xfd\x41\x84\xfd\x74\xc9\x2b\x57\x0f\x52\xed\x51\x57\xe5\x18\xf5\xfb\x85\xcf\x76\x93\xf2\x00\x85\x71\xee\xa7\xab\x9f\x50\x0f\x52\x1e\x5a\x9f\x63\xd2\x4d\x4e\x18\x5f\xe1\x72\xe4\xc0\x88\xfc\x0b\x64\x7c\xb6\x7e\x60\xc2\x23\x2c\x91\xaa\xaa\x8e\x90\x5f\xda\x7a\x9d\xf2\x08\xfe\xe9\xe2\x17\x93\x07\x48\xd7\x11\x74\xbf\xe6\xae\xf7\x4d\xa7\xcc\xa2\x3b\x22\x8f\x51\x9d\x06\xb1\x6b\xe6\xb7\xa2\xf1\x5f\xe5\xc9\xba\x5d\xe0\x7e\x93\xfa\xe4\xef\x4e\xf2\xc6\x4a\xe4\xe3\xe7\xc8\x4a\x9c\xbf\x49\x47\x68\x91\xc2\x37\x1b\x2b\x9c\x5c\xd4\xdc\xf4\x72\xb4\xae\x0f\xbd\xf9\xa1\xb4\xc9\xe6\x0f\x91\xc6\x5d\x6f\x0e\x93\x1f\x7a\x93\xbe\xfb\xcf\x71\x82\x43\x27\xb5\x68\x0d\x94\x9f\xdc\x72\x73\x8c\xe2\xeb\xb2\xfe\xdf\x53\xd7\xd3\xb5\x9a\x84\xeb\x1a\x17\x69\x47\x90\xac\x94\xc6\xeb\x09\xf9\xb4\xeb\xee\xcb\x45\xc6\x7b\x3d\xce\x67\xa1\xc1\x98\xdd\x4f\x9f\xf9\x71\x6a\x7a\x76\xaa\x6e\x68\xe9\xdd\x70\xec\x51\x26\xf3\x60\x97\x84\x7e\xc4\x59\x0d\xca\x74\x3d\x25\xbe\x65\x30\xde\xd4\x72\x26\x7a\xb2\xc9\x74\xed\x02\x65\x08\xdd\x56\x68\x06\xd6\x94\x8d\x30\x35\xe6\x4c\xda\xb2\x6f\xd1\x50\x5a\x5a\xfb\x59\x21\xe4\x70\x3a\x99\xe7\xa7\xf0\x1c\xa8\x51\x3e\xdd\x0a\xdd\x23\x3c\x87\xd3\xd7\xd0\x09\x8a\x0b\x4f\x0f\xfd\xfe\xb7\x6f\x70\xca\x4f\x9f\xc5\xf5\x56\x5c\x25\xa2\xc6\xfc\xd5\xd9\x3f\x5e\xfd\xf3\xe5\xcb\x97\xa7\xaf\x41\xdb\x72\x6c\x26\x53\x87\xda\x0a\xf9\xf4\xd9\x2c\x64\x4c\x8c\x0b\x34\x0e\x77\xc0\x77\x13\x43\x7f\xc8\x0b\x40\x66\xbb\x00\x87\x91\xc5\xf8\x0a\xa5\xa1\x70\x96\xa4\xc2\xdf\x56\x57\x48\x88\xd1\x41\x79\x88\x74\x31\x15\x3c\x0c\xc3\x1c\x88\xc9\x87\x0f\x36\xda\xe3\x6a\xc5\x56\xd5\x01\x98\xf1\xb8\xf3\x9a\xee\xba\xac\x32\x1e\xf7\x9a\x7a\xd6\x90\x88\xa5\xa1\x9c\xb8\x2f\x83\x8c\x1b\x31\x3d\xee\xf7\xe9\x79\x6c\x20\xc7\x7b\x6e\xc6\xe3\xcd\x26\xe3\x0d\xb5\xba\x38\xf9\x6d\x00\x44\x53\x89\x26\x57\x10\x00\x00")
 
 func assetsTemplatesLayoutHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -150,12 +259,32 @@ func assetsTemplatesLayoutHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/layout.html", size: 2118, mode: os.FileMode(420), modTime: time.Unix(1467317820, 0)}
+	info := bindataFileInfo{name: "assets/templates/layout.html", size: 4183, mode: os.FileMode(420), modTime: time.Unix(1792167226, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesLeasesHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x53\x4f\x4f\x1b\x3f\x10\xbd\xe7\x53\x8c\xf6\xc7\x81\x9f\x44\x62\x40\xa2\x07\xea\xf8\x02\x1c\x2a\xa1\x1e\xa0\xfd\x00\xde\xf5\x6c\xd6\x62\x19\x47\xb6\x43\x88\xac\xfd\xee\x95\xbd\x7f\x30\x9b\x96\x0a\xa9\x8a\xb4\xb1\xc7\x6f\xc6\x33\xef\x3d\x73\xe7\x0f\x2d\x8a\x05\x80\x6f\x20\x2c\x00\x00\x4a\x59\x3d\x6d\xac\xd9\x91\xba\x86\xff\xea\xab\xf8\xfb\xba\x00\xe8\x16\x9c\x0d\x60\xae\xf4\x0b\x54\xad\x74\x6e\x5d\x54\x86\xbc\xd4\x84\xb6\x88\x45\x78\x73\x29\x1e\x0f\xce\xe3\x33\x58\x49\x1b\x84\x16\xa5\x43\xc7\x59\x73\x99\x8e\xb7\xf1\x0b\x70\x1f\xa3\x8d\x69\x15\x5a\x07\xa6\x06\xdf\x20\xb8\x2c\xcd\x81\x74\xe0\x10\x09\xca\x03\x90\x51\x08\x17\xab\x94\xc8\xe5\x78\x6f\xe9\x09\x4a\x4f\xcb\x57\x97\xfe\x14\xd6\x72\xd7\xfa\x02\x1a\x8b\xf5\xba\x08\x01\x4a\xe9\x10\xba\x8e\xf5\x1d\x14\x82\xbb\xad\xa4\x31\x7b\xd3\x1e\xb6\x8d\xae\x0c\xc1\xb4\x5a\x5a\xac\x2d\xba\xa6\x10\x9c\x45\xa8\x80\x87\x3e\xc0\x99\x8c\x5d\x73\x96\x9a\x0f\x01\xf6\xda\x37\xb0\xba\xb3\xd6\x58\xe8\xba\xbe\xaf\x8c\x11\xd9\xa2\xf5\x90\xbe\xcb\xbd\xb4\xa4\x69\x53\x88\x10\x60\x05\x5d\xc7\x99\xd2\x2f\x43\x19\x24\xd5\xa7\x87\x00\xba\x86\xd5\x43\x24\xcc\xf5\x21\xee\x65\xd9\xe2\x58\xb1\xdf\xa4\xef\xb2\x34\x56\xa1\x45\x35\x6c\x2b\x43\x0a\xc9\xa1\x4a\xf4\xc7\x44\xdb\x2f\xe2\xb2\x81\xbd\x56\xbe\x59\x17\x17\x57\xe7\xdb\xd7\x42\x7c\x37\x0a\x39\xf3\x4d\x86\x10\xf7\x83\x40\x63\x94\xb3\xb1\x42\x08\x83\x86\xab\x1e\x33\xce\x1a\xf3\xec\xd0\xf3\x8d\xd9\x91\x87\xae\x1b\x3b\xd5\x54\x9b\x62\x9a\x6d\xbc\x27\x66\xa8\xb7\xcd\x34\xf1\x4f\x7a\x22\xb3\x27\xe8\xba\x48\x4f\xec\xae\x5f\x62\x9b\xa4\xe3\xf2\x58\xcd\x68\x06\x96\xa1\x0b\x91\x6d\xa2\x52\x39\xb1\xb3\xeb\x6e\x4c\xf5\x64\x8d\xac\x9a\x6f\xb7\x11\x9c\xfb\xc1\xe3\xab\x5f\x3e\xef\x7c\xe4\xf1\x94\x42\x98\x83\xff\x1f\x3c\x71\x5c\x9d\xb3\x7c\x34\xee\x95\xe0\xce\x5b\x43\x9b\xd4\xd8\xc8\x4f\x7c\x3a\x29\x98\xc3\xdf\x51\x3d\x95\xe5\x2c\x29\x2b\xfe\xbd\x0b\xbe\x24\x13\x24\x9b\xcd\x5d\xf0\x23\x96\x99\x07\x1f\xbd\xb4\x7e\x1e\xbc\x23\x35\x0b\x4d\x26\x3b\x4f\xf5\xb3\xb7\x3d\xcf\x7d\xc0\x6d\xab\x2b\xf9\x17\xb7\xe5\xef\xe0\xbd\xdb\x52\xe9\xdb\xde\x31\x23\x2b\x2a\xa2\xed\x9f\x3c\x17\x35\xe8\xd5\x3e\x92\x29\x1e\xa5\xb1\x7f\x7b\xca\x2b\xa3\x30\x49\x98\x58\x48\x98\x14\xfa\x08\x7a\x47\xea\x23\xe0\xb1\x23\xd3\x40\x31\x25\xb3\xcc\x14\x1b\x2d\x93\x3d\x08\x2d\xc8\x10\x72\xa6\x3f\xb2\x79\xce\xd2\xb1\xc9\x07\xbe\xc4\xa9\x32\x7b\xfa\x8c\xad\x27\x81\x4e\xf4\x19\x9c\x58\xb8\x5e\xc3\x6a\x14\xb4\x7f\xb6\xba\x86\x13\x0d\x5d\x77\xf6\xe6\xe7\x10\x22\x34\xfd\xe3\x40\xce\x27\xfc\xff\x16\xe6\x4c\xe9\x17\xb1\xf8\x35\x00\x14\x41\x27\x52\xae\x06\x00\x00")
+
+func assetsTemplatesLeasesHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesLeasesHtml,
+		"assets/templates/leases.html",
+	)
+}
+
+func assetsTemplatesLeasesHtml() (*asset, error) {
+	bytes, err := assetsTemplatesLeasesHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/leases.html", size: 1710, mode: os.FileMode(420), modTime: time.Unix(1792164404, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesLogHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x44\x8d\xcd\x6a\x84\x30\x14\x85\xf7\x79\x8a\x83\x5d\x37\x82\x4b\x9b\xba\xea\xa6\x50\x2c\x0c\xf3\x02\xd1\x5c\x34\x8c\x93\x84\x98\x38\x88\xe4\xdd\x87\x84\xf9\x59\xde\xef\xbb\xe7\x1c\xb1\x86\x7d\xa1\x8e\x01\x7c\xb4\x26\x48\x6d\xc8\xe3\x60\xc0\x4d\xab\x30\xb7\x90\x31\xd8\x2f\x06\x24\x06\x38\x4f\x45\x0d\x72\xbc\x4c\xde\x46\xa3\x5a\x18\x6b\x28\xfb\xc1\x7a\x45\xfe\x7d\x27\x26\xea\x47\xb5\x50\x7a\xc3\xb8\xc8\x75\xfd\xae\x5e\x1b\x55\x9e\x14\x73\xd3\x1d\x07\x78\x6f\x15\xf1\x5e\x5e\x09\x29\xe1\xe3\x49\x4e\xd1\xf0\xdf\x9f\x8c\x3e\x91\xd9\x79\x77\xf9\x41\xd4\x73\x53\xc2\xce\x53\x49\xff\xd9\xe9\x3f\x06\x17\x43\x91\x99\x32\x51\x2b\xbd\x75\xec\x1e\x00\x00\xff\xff\x0c\x02\x65\x26\xdd\x00\x00\x00")
+var _assetsTemplatesLogHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x54\x61\x8f\xe2\x36\x14\xfc\xce\xaf\x18\xb9\xfd\x70\x27\x1d\x49\x40\xbd\x7e\xa0\x09\x55\x7b\xd5\x55\x95\xba\x77\xd5\x2e\xfd\x01\x0e\x7e\x21\xee\x3a\x76\xea\x3c\xc3\xd2\x88\xff\x5e\x39\x84\xc0\xed\x6a\xb5\x02\x09\xe3\xe7\x37\x6f\x66\x3c\x49\xde\xf1\xd1\xd0\x7a\x06\x24\x5b\x67\x59\x6a\x4b\x1e\xfd\x0c\x38\x68\xc5\xf5\x0a\x32\xb0\xfb\x69\x06\x9c\x66\x40\xeb\x69\x28\x95\x72\xfb\xb8\xf3\x2e\x58\xb5\x82\x75\x96\x62\xbd\x74\x5e\x91\xbf\xfe\x3f\xcd\xf2\x74\x84\xce\x95\xde\x63\x6b\x64\xd7\x15\x62\x9a\x21\xe2\xc8\xbc\x5e\xae\xfb\x1e\xc9\x17\xa7\x28\xf9\x22\x1b\xc2\xe9\xd4\xf7\xd0\xd5\x79\xeb\x3e\x58\x9c\x4e\xf8\xee\x72\xe4\x3e\xd8\xe4\x8f\xdf\xce\x67\xc8\xaa\x58\x9b\x23\x16\x37\xc7\x36\xb6\xe6\x69\xbd\x8c\xb0\x23\xc4\x27\x67\x9c\xc7\x29\x32\x07\x72\x89\xda\x53\x55\x88\x9f\xb7\x71\xbb\xa8\xa4\xe9\x48\x5c\x68\x95\x6c\x51\xb2\x9d\x3f\x75\xc3\x8f\xa2\x4a\x06\xc3\x62\xfd\x97\x91\xda\xe6\xa9\x1c\x51\xc9\x74\xf4\x1a\x20\xfb\xf0\x36\xde\x40\xe9\x06\x6f\x10\x31\x31\x96\x56\x5d\x85\xbf\xa3\x7f\x47\x61\xa2\x63\xe5\x02\x8b\x61\x41\xde\x8b\xf7\x2f\x38\xf4\x3d\x4a\x39\x70\x4b\xad\x53\x94\x3e\x37\x35\xf5\xc1\xa6\x2f\x6c\x4c\x2b\x67\x8c\x3b\xa4\x37\x16\xbe\xa9\xe0\xf3\xd0\x32\x4a\x00\xf2\xca\xf9\x06\x0d\x71\xed\x54\x21\x76\xc4\x13\x40\x2c\xcc\xb5\x35\xda\x92\xc0\x90\x84\x42\x28\xdd\xb5\x46\x1e\x57\x38\xef\xcf\x4b\xe3\xb6\x8f\x43\x12\x80\x17\xd7\x96\x6b\xdb\x06\x06\x1f\x5b\x2a\x44\xad\x95\x22\x2b\x60\x65\x43\x31\x45\xc6\x79\x81\xbd\x34\x81\x0a\x31\x38\xbf\xbe\xb5\x33\xa2\x7d\xd3\xce\xf4\xc4\x97\x66\xf9\x8c\x63\x8c\xa4\x77\x06\xc3\xf9\x79\xd7\x08\xb4\x46\x6e\xa9\x76\x46\x91\x2f\xc4\x32\x5b\x2e\xe6\x59\xfc\x6e\x16\xcb\x55\x96\xad\xb2\x4c\x8c\x23\xc6\xcf\x48\xa3\xef\x71\xd0\x5c\x23\x79\x20\x7a\x3c\x87\x34\xf9\x85\x93\xcf\xce\x37\x92\x21\x96\x59\xf6\xe3\x80\xb3\xdc\x2c\x3e\xae\xb2\x1f\x56\xd9\x47\x71\x1b\x65\x01\xd6\x6c\xa8\x10\xff\x84\xa6\x05\x3b\x48\xb0\x6e\x08\xef\xfe\xde\x7c\x42\xb0\x86\xba\x0e\x12\xff\x39\x4b\xd0\x1d\x76\x7a\x4f\xf6\xfd\x64\x5d\x5e\x06\x66\x67\xdf\xba\xbc\xdf\x5d\x04\x8e\xb0\x79\x7a\xee\x18\x6f\x31\x8d\x4e\x9c\xd7\xcf\x65\x5c\x26\xb4\x17\xf0\xe8\xe5\xbc\x09\x4c\x6a\x1a\x0f\x7c\x0d\x1c\x2f\xab\xf2\xae\xc1\xab\xc2\x71\x11\x8e\xbb\x87\x4d\x14\x0f\x67\x3f\x40\x32\xca\x23\xd3\xd0\xf6\xb5\xaa\x3a\xe2\xeb\x5b\xe0\xce\xf9\x98\xca\x0f\x60\x1f\xec\x56\x32\xa9\x41\x40\x4d\xa8\xb4\xef\x18\x0b\xdc\xe9\x5f\x27\x0b\x93\x89\xce\xf5\xe1\x3c\x87\xea\xfb\x29\x55\xd7\x87\xf5\xea\xfc\xfa\xa1\x76\x07\x48\x63\xa6\x58\x47\x4b\xda\xc9\x8f\x29\x57\xb7\xeb\xbc\xf5\x14\x43\x97\xfc\xe9\x76\xa3\xfa\xf8\x06\x8a\xbb\xb3\x3c\x55\x7a\xbf\x9e\xfd\x3f\x00\xe0\xe2\x29\x6c\x5c\x05\x00\x00")
 
 func assetsTemplatesLogHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -170,12 +299,12 @@ func assetsTemplatesLogHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/log.html", size: 221, mode: os.FileMode(420), modTime: time.Unix(1467317820, 0)}
+	info := bindataFileInfo{name: "assets/templates/log.html", size: 1372, mode: os.FileMode(420), modTime: time.Unix(1792163522, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesNodeHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x56\x4d\x6f\xe3\x36\x10\xbd\xfb\x57\x0c\x94\xa0\xb6\x0f\x96\x72\xd9\x8b\x57\x16\x50\x6c\x7b\x58\x60\x11\x04\xd9\x43\x81\x16\x3d\xd0\xe2\xd8\x22\x56\x26\x59\x72\x94\x0f\x18\xfa\xef\x05\x29\x4a\x56\x64\x69\x9d\xa4\x29\x02\x38\xe2\xf0\x71\xf8\xf8\x48\xbe\x61\x6a\xe9\xb9\xc4\x6c\x06\x40\x1c\xb4\x41\x38\xce\x00\x00\xb8\xb0\xba\x64\xcf\x6b\x10\xb2\x14\x12\x3f\xfb\xe0\x96\xe5\x3f\xf6\x46\x55\x92\xaf\x41\xaa\x2e\xaa\x0c\x47\xd3\x8f\x68\xc6\xb9\x90\xfb\x35\xdc\x34\xed\x5c\x95\xca\xac\xe1\xea\xe6\x26\x04\x1e\x0b\x41\xb8\xb2\x9a\xe5\xb8\x76\x93\xae\x1e\x0d\xd3\xae\xab\x9e\x39\x22\x45\x20\xd1\x9f\xef\x6a\xf7\xc9\xfd\x75\xa0\x58\x2a\x8e\x2b\x55\x91\xae\x28\xc0\x0f\xcc\xec\x85\x5c\x91\xd2\x6b\xf8\xa4\x9f\x3a\xe8\x95\x83\x9a\x4a\x5a\x20\xb3\x2e\xd4\x03\x9a\x30\x20\xaf\x8c\x75\xc4\xb4\x12\x92\xd0\x34\x03\xd2\x24\x28\x92\xda\xdc\x08\x4d\x4e\x9a\xeb\xc5\xae\x92\x39\x09\x25\x17\xcb\x30\xf6\x7a\x11\xfd\xc5\x19\xb1\x15\xa9\xfd\xbe\xc4\xcd\x9c\x94\x2a\x49\xe8\xf9\xdf\xd1\x32\x0e\xdf\x8b\xe5\xe7\x80\x9d\xf7\x39\xcc\x97\x71\x5e\x8a\xfc\xc7\x29\x29\xb6\x59\x01\x1e\x85\xe4\xea\x31\x2e\x55\xce\x5c\x57\x5c\x18\xdc\xc1\x06\xae\x17\x18\x13\x33\x7b\xa4\x65\xac\x99\x41\x49\x76\x31\xf7\xa9\x76\x42\xf2\x45\x44\x1c\x58\xb4\x8c\x19\x91\x59\xcc\xdd\x98\xf9\xd2\x27\xac\x3d\x05\xf7\x9b\x26\xed\x7a\x52\x2e\x1e\x20\x2f\x99\xb5\x9b\x28\x57\x92\x98\x90\x68\x22\xb7\xce\x74\xa7\xcc\x01\x0e\x48\x85\xe2\x9b\x48\x2b\x4b\x3e\x0c\x90\x12\xdb\x96\xd8\x0e\x6a\x1a\xfe\x77\x95\x2b\xc9\x51\x5a\xe4\x01\xe9\xb0\xa6\xfd\x74\x8d\x22\xfb\xa2\x0e\x07\x26\x79\x9a\x50\xd1\xef\xe0\x59\xaa\x0d\x66\xc7\x23\xc4\xb7\x8a\x63\x1c\x60\x50\xd7\x69\xe2\x3a\xd2\x84\x78\x97\x33\x39\x25\x3d\xcb\xff\x4d\xe5\xac\x14\xf4\x7c\x69\x82\x16\xf7\xf6\x19\x7e\x25\x32\xf6\x52\x7a\x0f\x7a\x7b\xee\xef\xc4\x55\x45\x97\x92\x37\xa8\x77\x65\x47\x63\x5e\x91\x1d\x8d\x79\x4f\x76\x46\xd5\x88\x30\x5d\x03\xe0\x78\x04\xb1\x03\xfc\xa7\x9b\xc9\x8d\x80\xe8\x3b\x29\xad\x91\x47\x50\xd7\x3d\x30\x40\xba\xad\x88\x94\x04\x77\x10\x99\xbf\x1c\x9b\x28\x71\x77\x27\xe9\xc8\xde\xb2\x03\x42\x5d\x27\x96\x98\xa1\xa8\x3d\x93\x5b\x92\xb0\x25\xb9\x7a\xb2\xfe\x9f\xad\xf2\x1c\xad\x8d\x1c\x45\x43\x69\xd2\xa4\x1d\x10\xc3\xd2\xe2\x7f\x22\xa0\xf4\xd4\xfc\x9c\xc9\xbd\xbb\x54\x6e\x9d\x63\xb3\x4f\x0a\x73\xc7\x2a\x3b\xa2\xcb\x9b\x88\x19\xb4\xd5\x01\x2f\x4a\x73\xef\x61\x93\xec\xc6\xd4\x79\x13\x0d\xed\x96\x72\x49\x20\xbf\xde\x69\x0e\xde\x0f\x66\x3f\x8b\xbd\xfe\x12\xe7\x24\x1e\x10\x1c\xd7\x57\x9c\xd8\x70\xa7\x9b\x31\xc3\x33\xd2\x33\x50\x5f\x86\x4c\x25\xa3\x6c\x28\x14\x03\xe7\xc3\xd3\x9b\x54\xc9\x53\xb0\x99\x27\xfe\xfa\x1b\xd4\x75\x94\x5d\x8d\xc6\xd3\x84\x65\x30\xe8\x81\xba\xfe\x45\x6e\xad\xfe\xdc\xff\x3d\x27\x32\xb1\x05\xb8\x63\x55\x49\xd1\x3b\x79\x26\xd6\x7b\x52\x94\xa5\x56\x33\xd9\xce\xb1\x2f\x9f\x75\x21\x72\x25\xa1\xfb\x5a\xed\x44\x89\x51\x96\x26\x0e\x97\x81\x0d\x86\xc7\x46\x14\xfb\xdf\x88\xa2\x31\xef\x21\xea\xbd\x73\x40\x34\x4d\xb8\x78\x78\x8d\x93\x88\xec\x56\x49\x4c\x13\x31\x04\xbf\xee\x00\x1f\x8f\x70\xed\x56\x0a\xeb\x4d\xb3\xa8\x76\x50\x9a\xf8\xd2\x9b\xcd\x2e\x94\xe6\xe6\x61\x86\x3c\x34\xfd\xcb\x27\x02\xc1\x9b\x43\xeb\x1e\x23\xd3\x35\xfb\xbe\x92\xc3\x4b\x52\x64\x77\xe2\xac\x88\x17\xd9\xef\x4f\x82\xc0\x8e\x16\x82\xa2\x71\x5f\x1c\x19\x15\xfc\xff\xbc\xe3\x9b\xda\xbf\xc8\x33\x54\xc4\x09\xe1\x77\xbc\x55\xa5\xdd\xff\x13\xc6\x38\x6b\x09\x9d\xf7\xee\xc9\xd5\x17\x9b\x4c\x2b\x55\x73\xcf\xa5\x22\x88\x03\xcd\xf8\xab\xfd\x13\x8d\x82\xba\x0e\x1e\x10\x58\x9e\xe2\x42\xee\xd4\x69\xbb\x1b\xd4\x9e\x20\xfe\x83\x09\x6a\x1c\x3c\x76\x7a\x04\x33\xbf\x81\xba\x6e\x7c\xee\x34\x26\xb8\x6f\x77\x0c\xce\x3f\x5e\x38\x89\xaf\xd5\x67\x4e\x72\x52\xa1\x77\xee\xfb\xe6\xd1\x19\x46\xff\x70\xb5\xf9\x1c\xe0\xcb\x81\xc7\x77\x46\x39\x2a\xf1\x9d\x68\x1e\x5d\xe7\xc8\x91\x82\x15\xf4\x1a\xe8\x32\xb8\xc9\x6e\x86\x71\x49\x06\xd0\xe9\x32\x33\x7a\x79\xc6\x6b\xc2\xc4\x1a\x7f\xb6\xb9\x6d\xb0\xaf\xfb\xc5\x34\x83\x35\x37\x69\x7c\xf0\x52\x9a\x97\xbe\xf0\x56\x9b\x9b\xde\xed\x0f\xb6\xe0\x0f\x66\xf6\x61\x9e\xfb\x52\xd2\x81\x23\xf4\x8e\x43\x67\x8c\xee\xd3\x3d\x50\xb2\x59\x30\xeb\x7f\x03\x00\x00\xff\xff\x02\x32\x14\x63\xe6\x0e\x00\x00")
+var _assetsTemplatesNodeHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x5b\xff\x6f\xdb\xb8\x92\xff\x3d\x7f\xc5\x40\x5b\xbc\x24\x0f\xb1\x9d\xde\x61\xf7\x87\xd4\x76\xd1\x76\xbb\x6d\xf1\x92\xc6\x1b\xa7\xfb\x0e\xef\x70\x38\xd0\xd2\xd8\x26\x42\x91\x3a\x92\x4a\xe2\x0d\xfc\xbf\x1f\x86\xa2\xbe\xd9\x92\xad\x38\xd9\xc3\xa1\x40\x6a\x51\x43\xce\x70\xbe\x7c\x38\x1c\x52\x43\x63\x57\x02\xc7\x47\x00\x36\x82\x44\x23\x3c\x1d\x01\x00\x44\xdc\x24\x82\xad\x2e\x80\x4b\xc1\x25\xbe\x73\x8d\x33\x16\xde\x2d\xb4\x4a\x65\x74\x01\x52\x15\xad\x4a\x47\xa8\xab\x2d\x09\x8b\x22\x2e\x17\x17\x70\x9e\x3d\x87\x4a\x28\x7d\x01\x3f\x9d\x9f\xfb\x86\x87\x25\xb7\xd8\x33\x09\x0b\xf1\x82\x98\xf6\x1e\x34\x4b\xe8\xd5\xfa\x88\x04\x59\xc2\xd3\x16\xbf\x9f\xe6\x3f\xd3\xbf\x82\xa8\x2f\x55\x84\x3d\x95\xda\x24\xb5\x9e\x3c\x66\x7a\xc1\x65\xcf\xaa\xe4\x02\x7e\x4e\x1e\x0b\xd2\x9f\x88\x54\xa7\xd2\x80\xd5\x17\x4b\x75\x8f\xda\x77\x08\x53\x6d\x48\xb0\x44\x71\x69\x51\x67\x1d\x86\x03\xaf\x91\xa1\x09\x35\x4f\x2c\xa9\xe6\xcd\xc9\x3c\x95\xa1\xe5\x4a\x9e\x9c\xfa\xbe\x6f\x4e\x82\xff\x8c\x98\x65\x3d\xab\x16\x0b\x81\xa3\x63\xab\x94\xb0\x3c\x39\xfe\xaf\xe0\xb4\xef\x7f\x9f\x9c\xbe\xf3\xb4\xc7\x55\x19\x8e\x4f\xfb\xa1\xe0\xe1\x5d\x39\x28\xe6\xa3\x02\x3c\x70\x19\xa9\x87\xbe\x50\x21\x23\x7e\xfd\xa5\xc6\x39\x8c\xe0\xcd\x09\xf6\x2d\xd3\x0b\xb4\xa7\xfd\x84\x69\x94\xd6\x9c\x1c\xbb\xa1\xe6\x5c\x46\x27\x81\x8d\x80\x05\xa7\x7d\x66\xad\x3e\x39\xa6\x3e\xc7\xa7\x8e\xf5\xda\x89\x40\x7f\x87\x83\x7c\x3e\xc3\x88\xdf\x43\x28\x98\x31\xa3\x20\x54\xd2\x32\x2e\x51\x07\x34\xcf\xe1\x5c\xe9\x18\x62\xb4\x4b\x15\x8d\x82\x44\x19\xeb\x9a\x01\x86\x96\xcd\x04\xe6\x9d\xb2\x07\xf7\xb7\x17\x2a\x19\xa1\x34\x18\x79\x4a\xa2\xd5\xf9\x4f\x7a\x58\x8e\x3f\xa9\x38\x66\x32\x1a\x0e\xec\xb2\xfa\x22\x1a\x0f\x13\x8d\xe3\xa7\x27\xe8\x7f\x57\x11\xf6\x3d\x19\xac\xd7\xc3\x01\xbd\x18\x0e\x6c\x94\xd3\x0f\x07\x56\xb7\x8e\xff\x91\x4b\xa6\x57\xdb\xc3\x17\x0f\x00\x75\x4e\x59\x87\x82\x51\x85\xee\xe9\x09\xf8\xdc\x53\x4d\xb8\x94\x18\x15\xb4\x15\x2a\x80\xa1\x49\x98\xcc\xd5\x21\xd8\x0c\x05\xb8\xbf\xbd\x44\xf3\x98\xe9\x55\x00\x96\x5b\x81\xa3\xe0\xe9\xa9\x79\xb4\x60\x5c\x1b\x62\x21\x56\xc9\x92\x87\x4a\x42\xf1\xab\x27\x54\x78\x17\x8c\x87\x03\xa2\x1b\x43\xe2\xfa\x83\x55\xb0\x31\xe4\x1f\xa8\x0d\x57\xd2\xcd\xc6\x91\xd6\x05\x9d\xa5\xd6\x2a\x09\x64\x58\xe6\x9c\xcd\xc9\x34\x63\x06\x61\xbd\x1e\x90\x57\x0e\x8a\x01\xbf\xb3\xd8\xb5\x26\x5c\xf6\x66\x4e\xd2\xf7\xa9\x4c\xb8\x1c\x59\x9d\x62\x90\xcb\x3a\xb3\x12\x66\x56\xf6\x1e\x8d\xfb\x2f\xc2\x39\x4b\x85\x0d\xc6\x3f\x88\x74\x38\xc8\x18\x56\x95\x0f\x24\xf2\x03\xb7\xcb\x52\xee\xcf\x5a\x2b\x4d\x22\xcf\x74\x5d\x11\x16\x1f\x6d\x2f\x62\x72\x41\x1e\x69\x2c\xd3\x16\x34\xce\x53\x83\xd1\x05\x8d\xd2\x2f\xa7\xf9\xf4\x04\x28\xa3\xba\x61\xa8\x4d\x18\xac\x37\x02\x0c\xb9\x24\x94\xb0\xab\x04\x33\x0e\x01\x48\x16\xe3\x28\x98\x71\x59\x4c\xcb\xd1\xf4\x4c\x1c\x40\x22\x58\x88\x4b\x25\x22\xd4\xa3\x60\x90\x30\xbb\x1c\x58\x35\x90\xf8\x30\x08\x55\x78\xa7\x15\x0b\x97\xc1\xb8\xce\xe0\x10\x2d\xa7\xc9\x42\xb3\xa8\x83\x5e\x33\xba\x56\xcd\xf2\x39\x48\x65\xfd\xe0\x9f\xf2\x78\xde\xd4\xc1\x8b\x5d\x61\x9f\x9c\xb9\xc7\x67\xe6\x22\x47\xcd\xcc\x67\x97\xdc\x00\x69\x20\x73\x01\x26\x57\xa0\xec\x12\x35\x64\x1e\x06\xa9\xb4\x5c\x80\xf3\x33\x49\x28\x32\xd9\xe1\x44\x8d\x06\xaf\xb7\x75\x46\x8d\x89\xd2\xd6\x6c\x83\xc6\xcd\xe4\x53\x25\xc2\x94\xb6\xb0\x5e\x9f\xc1\xf4\xf7\xcb\xb2\x75\xfa\xfb\x65\xf1\xe2\xeb\xed\xed\xa4\x7c\x43\x4f\xfe\x55\x67\x39\x3e\x29\x29\x31\xb4\xfb\xd1\xd1\x91\x1d\x0c\x92\x53\xab\x34\xee\x63\xe2\x88\x9e\x3f\xf6\x15\x7b\x04\x35\x9f\x1b\x6c\x98\xc5\x51\x1b\x0a\x5c\xb1\xc7\x6b\xd7\x07\xd6\x6b\x1f\xd9\x65\xf8\x0e\xf9\xd8\xfb\x15\x9c\xfc\x7c\x7e\x1e\x9b\xd3\xe1\x80\xb7\x85\x7c\x36\xe8\x27\x91\x1a\x8b\xba\x1c\xf7\x9f\x4c\x4b\x2e\x17\x19\xbb\xbd\x58\xb3\x0f\x5c\x3a\x2b\xe3\x52\x85\x4c\x70\xbb\x67\x3d\x6a\x43\x24\xe1\x7b\x37\xc0\xd2\x3d\x13\x69\x75\x45\xc9\x19\xc1\x7a\xbd\x81\x59\x1a\x17\x04\x41\xa9\xe9\x3d\xa0\xb1\x6f\xcf\xfe\x54\x12\x47\x2c\x18\x3f\x7f\x32\x1f\xac\xd5\x0d\x41\xd2\x65\x26\x94\x8a\x98\x0e\xd3\x70\x2c\xb6\xe7\x60\x4c\x74\xf1\xf8\xf6\x97\xb0\x86\xb4\x07\x41\x98\x41\xdb\x15\xbb\xc8\x1b\x73\x0b\x00\x85\x99\x9b\xc4\x19\x68\x74\x58\x46\xde\x64\x97\xe8\xd0\x2c\x18\x4f\xd1\x6e\x03\x55\x67\xcd\xde\x20\x8b\xb8\x44\x73\xa0\x76\xc3\x38\x6a\xd0\xad\xe1\x7f\xe2\x28\xf8\xf9\x7c\x5b\xcb\xc4\x6e\x55\x82\xc7\x86\xb2\xbf\x7c\xbe\x85\xc1\x12\x99\xb0\xcb\xf7\x9a\x28\x47\x6f\x5f\xae\x77\x37\x50\xaf\x2a\xe8\x1e\xed\x87\x5e\xbc\x44\x09\x81\x11\xad\x20\xe1\x12\xc3\x3b\xd0\xb9\xaa\xce\x00\x1f\x13\x26\x23\x8c\xb2\xb5\xe4\xcd\xd7\xeb\xe9\xed\x19\xbc\x99\x5c\xdf\xdc\x3a\x73\xbd\xf9\x7a\x7b\x3b\xf9\x6f\x7a\x7c\xa9\x79\x3e\xcb\x7b\xae\x95\x8c\x51\xee\xc6\xb4\x0e\x89\xb1\x7f\xce\xb6\x49\x95\x3c\xb9\xc0\x2f\x4d\x10\xe4\x95\xf7\x59\xde\xff\xc1\xb4\xa9\x63\xdc\x96\x84\xcd\x08\xce\xe2\x66\xec\x6e\xa1\xff\x83\x02\xb1\x4b\x87\x2a\x66\xba\x2c\xd7\x67\x1d\xf8\x3f\xd0\x9f\xaa\x54\x87\x08\x41\x82\xba\x47\x6e\x10\xc0\x7a\xed\x68\x7a\x0f\x19\xfc\xe6\x98\xbe\x41\x5f\xd8\x3e\x27\xe7\x72\xae\x4a\xfc\xcf\xda\x3c\x51\x01\xc6\x81\x93\xdb\x0f\x51\x60\x75\x93\xe4\x55\xdb\x16\x7a\xde\x5a\x3b\x86\x03\x67\x9a\x92\xb0\xb3\x7b\x4c\x6d\xa4\xd2\x06\xcf\x28\x8d\x41\x91\x90\x51\x35\xaa\x78\xdf\xe8\xa8\x75\x87\xd1\x51\xeb\x43\x46\x67\x36\xdd\x0d\x3b\xa5\x7d\x3d\x27\xea\x01\xc1\xd4\xaa\x24\xc1\x28\xd8\xf4\xce\xc3\x60\x99\x10\xb5\x0d\x1a\x4c\x1a\x86\x68\x4c\x40\xc2\xea\x86\x38\x6e\x4f\xf5\x0f\x13\x45\x25\xad\x20\xe5\xf3\x03\x9a\xfb\xae\x14\x7c\x4b\x59\xff\x52\xf1\x8c\xe3\x96\xae\x0e\x14\x51\xe3\x3d\xbf\xc7\xbd\xea\xba\x71\x64\xcd\x72\x6e\xec\x5a\x69\xad\xce\x83\x94\xb6\x5a\xce\xb4\x30\x4b\x2d\x18\xcb\x85\x00\xc1\x8d\x45\x1f\xc1\xc5\x9e\xf8\x43\x68\xf9\x3d\xf6\xbf\xba\xa5\xe2\x92\x93\x68\xb4\x3a\x26\x4a\x5b\x8c\x1c\x50\xaf\x60\xb6\x02\xb7\x47\x8a\x30\x56\xc7\x06\x12\xad\x66\x68\x8a\xf0\xf3\x41\x5b\x93\x2c\xb7\x65\x93\x1a\x27\x2c\x35\x0d\x2e\x77\xb0\x1a\x4d\x1a\x77\x51\x23\x91\xb5\x9a\xbb\xc9\xf1\x0e\x14\x28\xa1\xe9\xb5\xc9\x93\xe7\xa6\x4e\x07\xad\x46\x3d\x84\xed\x9f\xde\x39\x77\xf2\xdd\x60\x94\xff\xcb\x73\x25\xab\x12\x97\x0d\x25\x5a\x91\xd6\x9c\xeb\x08\x64\xf7\x08\xdc\x1a\x20\x97\x30\xa0\x12\x94\x67\x60\x14\x84\xd9\x2e\x87\x2b\xaa\xc2\x29\xe0\xf6\x0c\x96\xce\x89\xb2\x35\xde\x00\x97\xa1\x48\x23\x8c\xce\x60\xc9\xe4\xc2\xad\xe6\x34\x78\x98\x65\xf5\x60\x10\x0d\x70\x0b\xcc\x00\x13\xfc\x1e\xbd\xa3\xa6\x54\x22\xc9\x22\xed\x2f\xd0\xcf\xfb\x4c\xc4\x91\xba\x7b\x91\xa6\x98\x81\x4c\xc4\x33\x27\x75\x35\x38\xd4\x83\x2c\xd3\x1b\x1f\x2a\x3e\x9e\x68\xb6\xf4\x6a\xf5\xce\xef\x97\xeb\xfa\xca\x82\x94\x74\x95\x2b\x00\x4e\x1c\x39\xa9\xb7\x60\x71\xda\xac\x95\xa6\xc5\xb0\xa5\xad\x08\xfd\x9b\x54\xde\x2a\x35\x5d\x92\x68\x35\x22\x80\xe6\x9d\x55\x81\x2d\xb7\x4b\x04\xc1\x8c\x05\x9d\x4a\xc0\x47\x4e\x50\xe1\x43\x6d\x9e\x0a\xb1\x02\x26\x62\x65\x2c\xf0\x38\xc6\x88\x33\x8b\x62\x75\xe1\x3c\x2b\x4f\x08\x63\xb6\x82\x19\x42\xc4\x30\x56\x92\xff\xc9\xe5\xa2\xc6\xfe\x64\xae\xf4\x1d\xf7\x4e\x43\xe3\x73\xb9\x38\x85\x87\x25\xa7\xc4\x2c\xaf\xd8\xc0\x1d\x62\x62\x48\x04\xc2\xb4\x3e\x5c\xb1\x3b\x04\x93\x6a\xf2\x56\x6a\x25\x0f\x74\x4c\xe7\x4a\x63\x56\xe4\x3e\x03\xec\x2f\xfa\x2e\xd5\x54\xa9\x85\x5e\xaf\xac\x47\xf7\xb7\x61\x6c\x5b\x7f\x6d\x2b\x72\xcb\x82\x71\x93\x89\x56\x81\xba\xad\xa5\x7b\xa2\xd5\x9c\x0b\xdc\xb3\x67\x60\xfb\xf2\x6d\xaa\x0f\x77\x08\x82\x24\xd1\x6a\x4e\xdb\x82\xa4\x43\xb5\x32\x52\x0f\x52\x28\x16\x95\x15\x4b\xea\x38\x1c\xb0\xbf\x50\xb4\x85\xd2\x2a\xb5\x5c\xe2\x41\xf2\x15\xbd\xff\x5a\x21\x49\x52\x2e\x0e\x13\x31\x4c\xd2\x9a\x70\xdd\x73\x3c\xbe\x90\x4c\xec\x76\x13\x83\x02\x43\xeb\xf7\x93\x86\x2f\xb6\xf7\x93\x55\x72\x80\xa1\x4a\x08\x3d\xc7\xd3\x6f\x5f\xbe\xfe\x98\x0c\x07\xfe\xb1\x8d\xe6\xdb\xf7\xdb\xbd\x34\xbf\xff\xf8\xb6\x9f\xe8\xf6\xf3\xcd\xd5\x5e\xa2\x1f\xd3\x9b\xb7\x5d\x88\xfe\xad\x89\x68\x38\xc8\x74\x31\x3e\x7a\xe1\xb2\x61\x9c\xda\xdb\x16\x8b\x02\x10\xa7\x28\xa3\x26\x60\xae\xc2\xed\xa5\x5a\x98\x5b\xf5\x1b\x45\x7b\x15\x53\x0e\x16\x4d\x23\xad\xc5\x3d\xa1\x16\xa6\x4d\xbe\xc2\xbf\xf3\x15\x9e\x36\x4c\x99\xb5\xb3\x25\x3c\x87\xd2\x6c\x2c\x5a\x8f\x0d\x68\x65\x19\x01\xba\x50\x0b\x70\xd8\x44\xd9\x13\xbd\x06\x62\xd5\x32\xcb\x8e\x40\xb9\xe5\xd6\x37\x94\x10\xed\x41\xbf\x83\x94\xe3\xc6\xdd\xa7\x97\xf1\xaf\x69\x9c\x80\xf6\x32\x6c\x4f\xec\xd5\x80\x23\x63\x31\x10\x6a\xd1\x01\x35\x3c\xb8\x78\xc4\xc8\xba\x92\xee\x3b\x01\xc7\xa6\x2d\xb6\x35\x9e\x55\xbe\xdc\x51\xac\x9a\xcf\x77\xaa\xbe\x98\xc7\x6f\x8c\x8b\x54\x3b\xc7\xa5\xc4\xcf\x60\x98\xd2\xb6\x01\xe6\xbe\xfd\x0c\x24\x3e\xda\xbc\xaa\x06\x6c\x6e\x51\x97\xbd\x3f\x66\xac\xaa\x0e\x52\x0f\x8d\x5f\xb9\xa1\x6d\x3b\xb9\x50\xeb\x39\x9c\x4f\xcc\xc6\x9e\x87\xa1\x03\x6d\xd7\xc9\x43\xeb\xce\x52\xb2\x5f\x96\x95\xc6\x4b\x15\xde\x61\xb4\xb7\x78\x3c\x34\x56\x2b\xb9\x18\x67\xf5\x73\x3a\xb8\xc3\x88\xce\x91\x5d\x23\xfc\x2d\x8e\x98\x59\xbe\x03\x26\xb3\x44\x2e\x4f\x98\xb9\x81\xd4\x50\xde\xe2\x8e\x48\x22\xae\x31\xb4\x4a\xaf\x2e\x60\x18\xaa\x28\x3b\x18\x25\xce\x03\xf7\xd4\x07\xda\x81\xba\xc4\xd9\x2e\x51\x16\x87\x2b\x59\x35\xb2\xbf\x63\x5a\x07\xc2\x85\x41\xdb\xf3\x56\xdf\x1b\x18\x37\x68\x9e\x53\x6d\xcb\x8c\x49\xd9\xda\x49\x6b\x8d\xe1\xb4\x68\x57\x1a\x7f\xe5\x7a\x87\x8f\x4e\x91\x12\xca\xe6\xf3\x8d\x2e\xb5\xd4\xb9\x56\xf1\xf6\xe2\xe7\x8b\xa9\xff\x7e\xde\x76\x20\xe8\x38\xd2\xa1\x7c\x09\x98\xd4\x52\x1a\x12\x94\x06\xcb\xf4\x8c\x09\xf1\xae\xb0\xd4\x31\xa5\xee\x44\x16\xa7\xc6\x52\x56\x8b\x71\x62\x57\xc1\xf8\xa5\x06\x33\x88\x7b\x0b\xad\x4e\x53\xcf\x32\x53\xcd\x99\x32\xb3\x29\xed\x39\x4f\x34\xba\xea\xcc\x57\xa5\xee\xf2\x26\x65\x2c\xb9\xa9\x6b\x6a\x37\x18\xbd\xde\x8d\xe2\xf5\x50\xac\x71\x5a\xaf\xe9\xc2\x48\xe6\xfe\x4d\x81\x42\x91\xba\x3f\xba\x37\x24\xa5\xab\x0e\x3d\xaa\x81\xbc\x60\x48\x1a\xaa\x38\xd7\x6e\x85\x0a\x0f\x79\x33\x57\x31\xd9\x3c\xd3\x6e\x63\xf4\x6a\x2b\xcb\x92\x34\x7f\xd0\xc2\xe2\x7a\xbe\xde\xba\x72\x85\x31\x45\x88\xe0\x31\xb7\x87\x45\x6d\xcc\x1e\x3b\x9c\x2e\x5d\x61\x7c\x49\x3c\xb6\xcf\x3c\xde\x7e\xe1\x1f\x5f\x1e\x76\x31\xc6\x3d\x37\x89\x7d\xb1\x97\x63\xc4\xf5\xf5\x55\xef\x8e\xb6\xee\x39\x20\x00\x9b\xa9\x7b\xcc\xd6\x80\x38\x0d\x97\x10\x3b\xd5\xd4\x0e\x9d\xb8\xa5\xc8\xf3\x7b\xd7\x97\x1e\x6d\xdc\x7f\x9a\xfc\xd8\x1b\x7d\xc5\x62\x4b\xc4\xfe\x84\xb6\xfa\x0c\x27\x5f\xae\xaf\x3e\xfc\xc7\xe4\xe6\xfa\xd3\xf4\xb4\x1e\x07\x9f\x26\x3f\xa6\x48\x35\x82\xb3\xca\xa5\x95\x50\x51\x3e\xe0\xbd\xbd\xf0\x8e\xb2\x96\x36\xe4\x63\x26\x44\x99\x04\xfc\xe1\xf9\xb4\x1d\xfb\xd6\xdc\x42\xa6\xf1\x0c\x75\xee\x18\x4d\x57\x3a\x62\x2e\x47\xc1\x79\x00\xee\x6a\xd7\x28\x78\xe0\x91\x5d\x5e\xc0\x2f\xe7\xc9\x63\xd5\x65\xf6\x4d\xba\x10\x64\xc3\x95\x98\x10\xc1\xb8\x4d\xb8\xaa\xcf\x3a\x2d\x34\x88\x97\x2d\x35\xbf\x6c\xbb\x6f\xa1\xcb\x0d\x8e\x7e\x20\xef\x53\xd9\x0e\x87\x09\x70\xcd\x54\x00\x4a\x7c\x31\xc3\x39\x98\x55\xbe\x92\x71\xde\x7b\x0b\x27\x97\x5c\xa6\x8f\xb4\x34\x45\x94\xa6\x68\x50\x52\xac\x4e\x5f\x1e\x08\x61\x92\x76\xde\x58\x14\x49\x59\x21\xe2\xeb\x79\xf7\xf7\x1f\x57\x1f\xdc\x42\xbb\xcf\xc3\x2b\x1e\x4b\x7d\xfc\x25\x81\x19\x55\x77\x48\x83\x34\x44\xee\xaf\x70\x92\xad\x0a\x32\x25\x7d\x08\xe8\xf5\xc2\x24\x25\x82\x19\x97\xd1\x28\x27\xea\xf5\x62\x8c\xab\x2d\x7e\xf5\x38\xad\x79\xf9\x77\x25\x71\xef\x5d\x86\x4a\xad\x3d\x8b\x12\xba\xe3\x83\x50\x0a\xfa\x66\x4b\xf0\xd6\x4c\x38\xdf\x75\xe6\xca\x27\x9d\x93\x94\x94\x78\x86\x54\x3f\x44\x03\x0f\x4b\xac\xf8\x0b\x37\x39\xf6\xd0\x69\xa5\xb7\x4b\x45\xb8\x92\xa9\x92\x35\x3d\x95\x13\x4d\xa5\x53\x64\x31\x49\xbf\x8a\x14\xcf\x4d\xb3\xdf\x15\xd2\x2a\xc2\x86\xb0\xe9\x16\xd5\x5b\xaa\xda\x88\x25\xba\xb7\x5a\x68\xa7\x70\x1f\xf2\x01\x52\x53\xa1\x96\x63\x03\x0e\x93\x28\x6d\xcd\x20\x9a\x48\x7c\x38\x39\xcd\xe4\xee\xf1\x4a\x11\x45\xc3\xfd\x7f\x88\xa8\xa9\x50\x0f\xd9\x76\xa3\xf3\xa2\x41\x5d\x5c\x6e\x08\xeb\x75\xb6\xd5\x4b\x25\x44\x28\xd8\x0a\x23\x3a\x1f\x7a\x7a\x6a\x20\xec\x1c\x26\xad\xf0\xea\x38\x34\xf8\x49\xcd\xdc\x6f\xcf\xcd\xcb\x6d\x63\x84\x7a\xe8\xed\x3c\xc1\xcc\x2d\x34\xfe\x95\x84\xca\x36\xbc\x5e\x89\xcf\xdf\x26\xf5\x3f\x08\xa1\x1e\x6e\x35\x0b\x29\x12\x4f\x1a\xae\xfb\x9d\x96\x2a\xda\x32\x20\xf5\xdb\xbd\x35\xaa\x03\x8f\xa3\xff\x8e\x8f\x35\xeb\x59\x6a\xf4\xf7\x2e\x2a\xe1\xbe\x65\x9b\x43\xb4\xe9\xc6\x7e\x4f\x97\xa6\x47\xc6\xfd\x7e\xa6\xdb\x97\x5e\x9f\xca\x88\x0e\x8b\xdc\x20\xd0\x9b\xd3\xb9\x26\xfd\xdc\xd6\xf9\xcb\x25\x15\xaf\x21\xa9\x28\x25\x15\x2d\x92\xee\xf0\x8e\x5a\x64\x6c\x7a\xcb\x6f\xc4\xdf\x3c\xdb\x59\x3e\x66\xd5\x0b\x48\x10\xf7\xdc\xfc\xda\xb8\xb9\xe2\x3b\x4e\xa8\x5f\x39\xbe\xef\xc9\x76\x6c\x50\x8a\x8b\x1d\x6e\x9d\xa8\x6c\x2e\x9e\x61\xa5\x37\x75\x33\xa5\x72\x46\xd2\xbc\xa7\x49\xe4\xab\xf1\x73\x2c\x45\xfb\xf3\xdc\x52\xc7\x74\x65\x9f\xcd\xe7\x3c\xcc\x2f\x5f\x3b\x81\x7f\x64\x2c\x9a\x3c\xab\x04\xb2\xfa\x54\x4a\x54\xdb\x20\xee\x0c\x70\x34\x9f\xc0\xc5\xd6\x28\x70\xec\x7b\x59\xcb\x16\xe2\xf9\xda\xc5\x06\xf4\x65\xc4\x7e\x9e\x34\x37\x78\x58\x2a\x83\xfe\xac\xb6\x32\x61\x95\x5a\x9f\x08\xf9\x99\x53\x7d\x4a\x67\x37\x04\xac\x6a\x85\xcf\x46\xa1\x36\xd5\xed\xf7\xc1\x1f\x9b\xb5\xd7\xd5\xdb\xb7\xfc\xd6\x67\x4b\x5d\x32\x3f\x3e\xdf\xcc\xb0\x2a\xef\x01\xaa\x9f\x47\xd0\x70\x3d\x9d\xca\xda\x94\xf7\x7a\x74\xcd\x17\x75\x2a\xcb\x46\x7f\x7f\xe2\xdb\xaf\xce\x87\x7e\x6a\x6c\xa7\x0d\x76\xb9\x42\x16\x32\xfe\x4d\xce\x4c\xf2\xae\xfa\x77\x5b\xa4\x3d\x1e\xfe\x52\x89\x07\xc6\x5d\x68\x7a\x76\xed\xc0\xf8\xdb\x52\x1b\xb1\x9d\x1b\xa3\x44\x28\xcf\xec\x0a\xf5\xc2\x55\x5d\x37\xc8\xff\xaf\xe6\x88\x5a\x1f\x32\x47\x77\x67\xab\x69\x8e\x35\xcf\xf5\x13\x19\x44\xfc\xfe\x15\x41\x63\x47\xdc\xbc\x21\xbf\x84\x8b\x51\x71\x11\xfa\xc8\x13\x51\x3d\x7c\x4c\x1f\x5c\xd1\xf7\x31\xe3\x57\x53\xed\x92\x13\x80\xae\xfa\xa1\xb9\xef\xa0\xc5\xed\x43\xcf\x4a\x7f\x72\x99\xe1\x20\xd9\xf7\x0d\x52\x7e\xb5\xd2\x3f\xba\x4f\xbc\x02\xe0\x51\x16\xbf\x74\xb8\x1f\x8c\xdb\x70\xe3\x26\x95\x9b\x78\xb1\x1c\x4f\xf8\xd6\xd7\x4a\xcb\xf1\xc7\x94\x8b\x86\xe6\xcf\x8f\xdc\x65\x76\x0d\x77\xea\x96\xd9\xf5\x35\x6c\xe8\xe5\xcb\xdc\xdb\x2f\xe8\xdc\xaf\xda\xba\x69\x4c\x52\xb5\xcb\x9b\x2e\x46\x75\xbd\x1f\x35\xae\xca\x37\x74\xb3\xa1\x78\x49\x2c\x74\xae\xc1\x4a\xf0\x79\x31\xfb\xdf\xcc\xbf\x50\xab\x62\xcb\xd9\xf7\x52\x96\xed\xf5\x5b\x9a\xd9\x08\x0b\x0b\xfd\x7f\x32\x6e\xb3\x1b\x5b\x7d\xd2\x87\x2f\xe6\x9f\xc3\x7a\x9d\x1d\x91\x94\x7d\xfc\xc5\x8f\xc2\x83\xb7\x7f\xd4\xb0\x96\xd0\x7b\x17\xd6\x96\xfa\xa8\x04\x75\x15\x5e\x0b\x48\xf5\x53\xca\xbe\xea\xa2\xfd\xfa\x5e\xd7\x34\xd5\xda\x7e\xfe\x79\x8a\xf7\xd2\x42\xda\x6a\xe0\xe5\x02\x13\xdf\x4f\x71\xd4\x9f\x64\x07\x3d\xfd\x09\xdf\x41\xe9\x32\xea\xbe\x73\xae\x62\x1b\xef\x79\xba\x71\x54\xec\xcb\x97\x2e\x47\x9a\x5a\x4d\x5b\x80\xf5\xba\x8b\x1c\x47\x2d\x58\xbb\x65\xd6\x1a\xa1\xf3\xb3\x16\x8b\x36\x91\x92\xa3\x5c\x5f\x5f\xfd\x83\x8b\x6e\x67\x72\xb9\x46\xa9\x0a\x9a\x6d\x07\xa7\xdf\xbe\xfc\xe3\xdb\xe5\xe5\x19\x08\x7e\x87\xc2\xdd\x20\xa4\x64\xe4\xfa\xfa\x0a\x1c\x91\x0e\xc6\xd7\xd7\x57\xef\x37\xa7\xdc\x2c\x4a\xe5\x92\x52\xe7\x9a\x48\xb7\x4b\x49\xef\xf6\x5c\x4a\x0a\xc6\x56\x29\x30\xc4\x7c\xa7\xa8\x65\x30\x6c\xcc\xa0\x19\xec\x9b\x97\x90\x16\x6f\xda\x15\xd1\x79\x63\x35\xd8\xf6\x0e\xb3\xe1\x29\x4f\x4f\x45\xe3\xbe\x61\x8e\x5e\x69\xe1\x6e\x0f\xf1\x57\xce\x47\x2a\xf3\x6e\xcb\x40\xfe\xb2\x69\xbc\x62\xca\x51\x18\xa5\xd2\x5a\xb7\xcf\xc6\x9a\x52\xa1\xae\xdc\x88\x1f\x0e\x68\x9f\x41\x64\x4f\x4f\x83\xbf\xc3\x07\x30\x98\x30\xcd\x2c\xba\x54\xff\x8c\x6e\x84\xd8\x25\xa3\xef\xfb\xd0\x6d\x16\x61\xce\x51\x44\xc0\x8d\x3c\xb6\x60\x50\x5a\x60\x42\xc9\x45\x56\x31\x20\x22\xbf\x63\x82\x6c\xc3\x60\xfa\xf0\xf7\x81\xe3\x9a\x7d\x74\xcc\xa3\xfa\xee\xa1\xf6\x11\x32\x74\xde\xa0\xcf\xf2\xaf\x66\x69\xd0\xf1\xd1\x70\x10\xf1\xfb\xf1\xd1\xff\x0e\x00\xd3\x4c\xbd\x3e\xe5\x3e\x00\x00")
 
 func assetsTemplatesNodeHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -190,12 +319,12 @@ func assetsTemplatesNodeHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/node.html", size: 3814, mode: os.FileMode(420), modTime: time.Unix(1487262532, 0)}
+	info := bindataFileInfo{name: "assets/templates/node.html", size: 16101, mode: os.FileMode(420), modTime: time.Unix(1792167188, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesNotfoundHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x54\xbb\x6e\xdc\x3a\x10\xad\xef\x7e\xc5\x98\xb7\x0d\x45\xac\xd3\xa4\xa0\x04\x24\x41\x8a\x74\x29\x12\x20\xed\x88\x1c\x49\xdc\x50\xa4\x4c\x8e\x64\xef\xdf\x07\x5c\x69\x1f\x36\x1c\xc0\x08\x52\x2c\xb8\x87\x9c\x39\xf3\x3a\x23\x7d\x67\xa3\xe1\xe3\x44\x30\xf0\xe8\x9b\x9d\x2e\x07\x78\x0c\x7d\x2d\x28\x88\x66\x07\xa0\x07\x42\x5b\xfe\x00\xe8\x91\x18\xc1\x0c\x98\x32\x71\x2d\x66\xee\xe4\x07\x71\xfb\x34\x30\x4f\x92\x1e\x66\xb7\xd4\xe2\xa7\xfc\xf1\x51\x7e\x8e\xe3\x84\xec\x5a\x4f\x02\x4c\x0c\x4c\x81\x6b\xf1\xf5\x4b\x4d\xb6\xa7\x67\x9e\x01\x47\xaa\xc5\xe2\xe8\x71\x8a\x89\x6f\x8c\x1f\x9d\xe5\xa1\xb6\xb4\x38\x43\xf2\x04\xde\x81\x0b\x8e\x1d\x7a\x99\x0d\x7a\xaa\xf7\xa2\xd9\xad\x4c\xec\xd8\x53\x63\x69\x8c\x40\x29\xc5\xa4\xd5\x7a\xb3\x3d\x7b\x17\x7e\x41\x22\x5f\x8b\xcc\x47\x4f\x79\x20\x62\x01\x43\xa2\xae\x16\x4a\x19\x1b\x0e\xb9\x32\x3e\xce\xb6\xf3\x98\xa8\x32\x71\x54\x78\xc0\x27\xe5\x5d\x9b\x15\x3f\x3a\x66\x4a\xb2\x8d\x91\x33\x27\x9c\xd4\xfb\x6a\x5f\xed\x95\xc9\x59\x5d\xee\x2a\x93\xf3\x25\x9b\x6c\x92\x9b\x18\x72\x32\x6f\xa0\x3f\x3c\xcc\x94\x8e\xea\xfe\xc4\xb9\x82\x6a\x74\xa1\x3a\x64\xd1\x68\xb5\x52\x35\x7f\xc1\xfb\xa7\xb4\x0f\xb7\x59\x3f\x0f\xf2\x86\x66\x95\xa2\x2d\x75\x38\x7b\xde\x4a\x06\xd0\xea\x2c\x14\xdd\x46\x7b\xdc\x92\x0d\xb8\x80\xf1\x98\x73\x2d\x02\x2e\x2d\x26\x58\x0f\xb9\xb9\x9f\x61\xe7\x9e\xc8\x4a\x8e\x93\x80\x14\x3d\x9d\xac\x5d\x8f\xec\x62\xd8\x74\x02\xa0\xad\xbb\x90\x15\x7d\xa0\x0b\x94\x64\xe7\x67\x67\x45\xb3\xfb\x4f\xdf\x49\x09\x9f\x12\x06\x0b\xe5\xc7\xb1\xef\x3d\x41\x4f\x0c\x7d\x8a\xf3\x44\x16\xba\x98\xa0\xa5\xd2\x0f\x18\x63\xeb\x3c\x81\x75\x79\xf2\x78\x04\x29\x0b\xc1\x0d\xff\x96\x56\x29\x89\x52\x61\x2f\x65\xcd\xcc\x31\x40\x59\x97\x5a\xac\x40\xbc\xb0\x5f\x83\x0a\xb0\xc8\xb8\x81\x92\xab\xf7\x38\xe5\xcb\x35\xa6\xbe\xac\xcf\xff\x6d\x96\xf4\x84\xe3\xe4\x49\x6e\xee\x67\x4b\xb9\x5f\x43\x96\x69\x4f\x18\xce\x41\x72\x92\x31\xf8\xa3\x68\xbe\xaf\xb5\x5d\x7b\xa4\x55\xb1\x7b\xcd\xc7\x99\x18\x64\x8b\xe9\x34\xe1\x7f\x6d\xa3\xd5\xda\x86\x15\xe0\x8b\x66\xb4\x65\x16\x17\xcd\x88\xd3\x62\x6a\x85\xa5\xd3\xca\xba\xe5\x32\xd6\x2b\xd0\x2a\xe0\x72\x56\xe0\x6b\xd3\xbe\x6a\x61\xb8\x6f\xbe\x61\x4f\x10\x22\x43\x17\xe7\x60\xb5\x1a\xee\xcf\x2c\x1b\xa1\x56\xab\x12\xb5\x5a\xbf\x6e\xbf\x03\x00\x00\xff\xff\x11\xbe\xda\xcb\xee\x04\x00\x00")
+var _assetsTemplatesNotfoundHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x53\xbb\x6e\xec\x36\x10\xad\xbd\x5f\x31\x66\xda\x50\x84\x9d\x26\x05\x25\x20\x09\x52\xa4\x73\xe1\x00\x69\x47\xe4\x48\xe2\x86\x22\x65\x72\x24\xef\x62\xb1\xff\x1e\x70\xb5\x2f\x1b\x0e\x12\x5c\xe0\x16\x82\x38\xe4\x99\x33\xaf\x33\xfa\xd1\x46\xc3\xfb\x89\x60\xe0\xd1\x37\x1b\x5d\x7e\xe0\x31\xf4\xb5\xa0\x20\x9a\x0d\x80\x1e\x08\x6d\x39\x00\xe8\x91\x18\xc1\x0c\x98\x32\x71\x2d\x66\xee\xe4\xcf\xe2\xfe\x69\x60\x9e\x24\xbd\xcd\x6e\xa9\xc5\x5f\xf2\xcf\x5f\xe4\x6f\x71\x9c\x90\x5d\xeb\x49\x80\x89\x81\x29\x70\x2d\xfe\xf8\xbd\x26\xdb\xd3\x07\xcf\x80\x23\xd5\x62\x71\xf4\x3e\xc5\xc4\x77\xe0\x77\x67\x79\xa8\x2d\x2d\xce\x90\x3c\x19\x3f\x82\x0b\x8e\x1d\x7a\x99\x0d\x7a\xaa\x9f\x44\xb3\x59\x99\xd8\xb1\xa7\xe6\x70\x80\xea\xb5\x9c\x5e\x12\x75\x6e\x07\xc7\xa3\xa5\x31\x02\xa5\x14\x93\x56\x2b\x66\xc5\x7b\x17\xfe\x86\x44\xbe\x16\xce\xc4\x20\x60\x48\xd4\xd5\xe2\x70\x80\x16\x33\xc1\xf1\xa8\x3a\x5c\xca\x4b\xe5\x4c\xbc\x06\xb9\x39\x65\xde\x7b\xca\x03\x11\x5f\x5c\x95\x32\x36\x6c\x73\x65\x7c\x9c\x6d\xe7\x31\x51\x65\xe2\xa8\x70\x8b\x3b\xe5\x5d\x9b\x15\xbf\x3b\x66\x4a\xb2\x8d\x91\x33\x27\x9c\xd4\x4f\xd5\x53\xf5\xa4\x4c\xce\xea\x7a\x57\x99\x9c\xaf\xe1\xb2\x49\x6e\x62\xc8\xc9\xfc\x0f\xfa\xed\xdb\x4c\x69\xaf\x9e\x4f\x9c\xab\x51\x8d\x2e\x54\xdb\x2c\x1a\xad\x56\xaa\xe6\x1b\x78\xff\x2d\xed\xed\x7d\xd6\x1f\x83\xfc\x77\xb3\xee\xfa\x5c\xca\xb7\xd4\xe1\xec\xf9\x5c\x3c\x80\x56\x17\xe1\xe9\x36\xda\xfd\x39\xed\x80\x0b\x18\x8f\x39\xd7\x22\xe0\xd2\x62\x82\xf5\x27\xcf\xee\x17\xb3\x73\x3b\xb2\x92\xe3\x24\x20\x45\x4f\x27\xb4\xeb\x91\x5d\x0c\x67\xdd\x01\x68\xeb\xae\x64\x45\x6f\xe8\x02\x25\xd9\xf9\xd9\x59\xd1\x6c\x1e\xf4\xa3\x94\xf0\x6b\xc2\x60\xa1\x7c\x1c\xfb\xde\x13\xf4\xc4\xd0\xa7\x38\x4f\x64\xa1\x8b\x09\x5a\x2a\x03\x85\x31\xb6\xce\x13\x58\x97\x27\x8f\x7b\x90\xb2\x10\xdc\xf1\x9f\xd3\x2a\x25\x51\x2a\xec\xa5\xac\x99\x39\x06\x28\xeb\x57\x8b\xd5\x10\x9f\xf0\x6b\x50\x01\x16\x19\xcf\x46\x2d\x4c\xf4\x1e\xa7\x7c\xbd\xc6\xd4\x97\x75\xfc\xa1\xcd\x92\x76\x38\x4e\x9e\xe4\xd9\xfd\x82\x94\x65\x47\x1e\x4e\x35\xe7\x09\xc3\x25\x48\x4e\x32\x06\xbf\x17\xcd\xeb\x89\x19\x6e\x3d\xd2\xaa\xe0\xbe\xf2\x29\xfb\x20\x5b\x4c\xa2\xf9\x0e\x18\xad\xd6\x36\xac\x06\x7e\x6a\x46\x5b\x66\xf1\x85\x7a\x44\x53\x16\x5c\x2b\x2c\x3d\x57\xd6\x2d\xd7\x01\xdf\x0c\xad\x02\x2e\x17\x55\x7e\x35\xf7\x9b\x2a\x86\xe7\xe6\x05\x7b\x82\x10\x19\xba\x38\x07\xab\xd5\xf0\xdc\x6c\x3e\x10\x6a\xb5\x6a\x52\xab\x81\x47\xdf\x6c\xfe\x19\x00\x6f\xc7\x4c\xf5\x48\x05\x00\x00")
 
 func assetsTemplatesNotfoundHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -210,12 +339,32 @@ func assetsTemplatesNotfoundHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/notfound.html", size: 1262, mode: os.FileMode(420), modTime: time.Unix(1467317820, 0)}
+	info := bindataFileInfo{name: "assets/templates/notfound.html", size: 1352, mode: os.FileMode(420), modTime: time.Unix(1792162013, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesRunHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x54\x5d\x6b\xdb\x30\x14\x7d\xae\x7f\xc5\xc5\x2d\x34\x79\xb0\xd5\x15\xf6\x92\x2a\x7e\xd8\xc7\x43\x61\x94\xb2\x3e\x0c\x36\xf6\xa0\x58\xd7\xb6\x98\x22\x19\xe9\xba\x4b\x30\xf9\xef\x43\xb2\x93\x7a\xe9\x58\xb3\x0d\x46\x20\x91\xef\x3d\x3a\x27\xe7\x98\x7b\xb9\xa7\xad\xc6\x22\x01\x20\x09\xad\x43\xe8\x13\x00\xa9\x7c\xab\xc5\x76\x01\xca\x68\x65\xf0\x26\x01\x58\x89\xf2\x5b\xed\x6c\x67\xe4\x02\x8c\x1d\x6b\xd6\x49\x74\x4f\xcf\xad\x90\x52\x99\x7a\x01\x57\xe1\x69\x97\x00\xe4\x24\x56\x1a\x81\x9a\x48\x3b\xe5\x38\xaf\x5e\x87\xcf\x01\xe8\x4b\x67\xb5\x46\x17\x81\x6b\xb1\xc9\x1a\x54\x75\x43\x0b\x78\x75\x7d\xd5\x6e\x02\xcc\x3e\xa2\xab\xb4\xfd\x9e\x6d\x17\x30\xa0\x87\xcb\x9c\x8d\x16\xb8\x2f\x9d\x6a\x29\x78\xb9\x98\x55\x9d\x29\x49\x59\x33\x9b\x47\xc6\x8b\x59\xfa\x45\x0a\x12\x19\xd9\xba\xd6\xb8\xbc\x24\x6b\x35\xa9\xf6\xf2\x6b\x3a\xcf\xc7\xf3\x6c\x1e\x09\xe7\x37\x81\x72\xa4\xe2\x52\x3d\x42\xa9\x85\xf7\xcb\xb4\xb4\x86\x84\x32\xe8\xd2\x20\xc1\x9b\xeb\x7d\xa3\xef\x41\x55\x60\x2c\x41\x7e\x67\x25\x7e\xec\x4c\xfe\x40\xc2\x11\xca\xfc\xd6\x7f\x46\x67\x61\xb7\x1b\x30\x93\xbe\x6d\xdb\x69\x9f\x70\x43\x99\x32\x95\xed\x7b\x40\xed\xf1\x70\xa5\x9e\xb0\x7e\x12\x8a\x1e\x48\x50\xe7\xf3\xf7\x9b\xfd\x11\xae\xf6\xd7\xa5\x30\x35\xba\x27\x82\x58\xf4\x5d\x59\xa2\xf7\xa1\x6a\xe4\xc0\x7a\x74\x48\x8b\xbe\x1f\x34\xf2\x3b\xb1\x0e\x17\xe1\x7c\x5f\x09\xaa\xb7\xef\x60\xb7\xe3\xac\xb9\x8e\xb6\x2b\xeb\xd6\xb0\x46\x6a\xac\x5c\xa6\xad\xf5\x14\xd3\x00\xe0\xc3\xab\x1e\x23\x19\xdf\x7b\xf8\xce\x4a\x6b\x24\x1a\x8f\x72\x44\x06\xac\x2b\x92\x33\x4e\x4d\xf1\xd6\xae\xd7\xc2\x48\xce\xa8\x89\x15\x59\xf0\xd6\x61\x31\x95\x1f\x21\xf1\x3f\x84\x1e\x67\x24\x0f\x44\x2c\x30\x1d\x93\x3e\x90\xb4\x1d\x4d\x38\x93\x33\x80\x67\xbc\x03\xea\x40\x0b\x19\x3c\xef\xbe\xe9\xaa\xfc\x03\x9a\x10\xc9\x6a\x4b\xe8\x81\x8b\xbd\xc3\x15\x19\x58\x91\xc9\x36\x3e\xfe\x48\xac\x44\xa7\x29\x85\xc6\x61\xb5\x4c\x99\xb1\x12\xd9\x71\xae\xcc\x75\x86\x3d\x8b\x96\xf9\xa8\x95\x16\xdc\xb7\xc2\xec\xf9\x6b\xbd\x6d\x1b\x55\x5a\x03\x87\x53\x56\x29\x8d\x69\xc1\x59\xc0\x15\xe0\x47\x9b\x22\xb8\x3c\x25\x14\x74\xee\x84\x50\xd0\xb9\xdf\x84\x82\xce\xfd\xb7\x50\xd0\xb9\xbf\x09\x25\xda\x3c\x35\x94\x38\xa6\x93\x54\x4e\x9c\xe5\xe3\xe6\x74\x9e\x4e\x91\x8d\xd3\xff\x92\xec\xd1\x8a\xf8\x59\x36\x36\xff\x4c\xf6\x5e\x1d\x49\x3e\xcd\xd8\x5a\xe6\xf7\xce\x86\x45\x91\xdf\xab\xd3\xd8\xc2\x06\x02\x1f\x57\xd0\x3f\x18\xf9\xf5\x4a\x1b\x6c\x0d\x5b\x8c\xab\xe2\xce\x1a\xe4\x4c\x15\x2f\x78\xe5\x2c\x2e\x9c\xb8\xa6\x58\xd8\x53\x45\xc2\x99\x54\x8f\x45\xf2\x23\x00\x00\xff\xff\x2b\xc4\xd3\x55\xe6\x06\x00\x00")
+var _assetsTemplatesPortsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\x92\xdf\x8e\xdb\x2c\x10\xc5\xef\xfd\x14\x47\x7c\xd7\x6b\xf2\x45\xad\xb4\xda\x62\x6e\x2a\x55\xbd\xda\xf6\x15\x88\x99\xc4\xa8\x0e\xa4\xc0\xee\xa6\x42\xbc\x7b\x35\x38\x4e\xf7\x5f\x2b\x4b\x16\x9c\xf9\xcd\x99\x63\x8c\x4a\xf9\xd7\x4c\xba\x03\xf2\x84\xd2\x01\xc0\xce\x8c\x3f\x0e\x31\x3c\x78\x7b\x87\xff\xf6\x1f\xf9\xf9\xd4\x01\xb5\xeb\x80\x7e\x0c\x3e\x1b\xe7\x29\x5e\xe0\xa3\x39\xdf\x3c\x39\x9b\xa7\x3b\xdc\x6e\x36\xa7\xf3\x42\x2a\x79\xb1\x55\xd6\x3d\x62\x9c\x4d\x4a\x83\xb8\xb6\x0a\x1e\xa7\xa6\xad\xfe\x1e\x62\x4e\x4a\x4e\xdb\x26\x64\xb3\x9b\x69\x85\x97\x4d\x7b\xdf\xec\x42\xb4\x14\xc9\x5e\xb6\x63\xf0\x96\x7c\x22\xdb\x7c\xb8\x31\x2e\x0b\x5e\x4e\x68\x69\x06\x71\xbb\x39\x9d\x45\x9b\xa0\x64\x9e\xde\x01\xfe\xdf\x2e\x44\x0c\x23\xa5\xf4\x6f\xe8\xcb\x6c\x0e\xaf\x08\xfd\x35\xa4\xfc\x47\x53\x72\x4d\x51\x0a\xa2\xf1\x07\x42\xcf\xc3\x13\x6a\xbd\x36\xc5\x52\xe0\xf6\xe8\x3f\x87\x79\x76\xc9\x05\x8f\x5a\xd7\x0f\xb6\xdc\x13\x05\xb2\xcb\x33\x0d\xe2\x14\x62\xc6\x43\x22\x8b\x63\x88\x84\x3c\x19\x8f\xe0\x47\x12\xa5\x80\xbc\x45\xad\x6b\x16\x4e\x63\x75\x29\xcb\x3c\xd4\xaa\x64\xb6\x6f\x8a\x6e\x0f\xfa\x89\xfe\xdb\x13\xff\x3a\xe1\x83\x25\xc1\xa8\xc1\x14\x69\x3f\xb0\xeb\xce\x24\x42\xad\x92\x6b\x92\xed\xee\xcd\x91\x05\xa1\x59\xc1\x33\x45\x49\xc3\xf3\x68\x6e\x0d\x5c\x58\x6c\x6b\x7d\x4e\x5d\x83\xbe\xcd\xa3\xc6\x60\x89\x2d\x7a\x3e\xd8\x86\x34\xe5\xdd\xe4\x3d\x1f\xf4\x2b\x9b\x17\xa7\x7d\xc9\xb1\x96\x72\x7c\x61\x81\x31\xcc\xe9\x64\xfc\x20\x3e\x08\xad\x9c\xbe\x0f\x9e\x94\x74\xfa\xef\x76\x2d\x34\x5f\x48\xd9\xee\x9b\xee\x94\xb4\xee\x51\x77\xbf\x07\x00\xe8\xa2\xbf\xe7\x2b\x03\x00\x00")
+
+func assetsTemplatesPortsHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesPortsHtml,
+		"assets/templates/ports.html",
+	)
+}
+
+func assetsTemplatesPortsHtml() (*asset, error) {
+	bytes, err := assetsTemplatesPortsHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/ports.html", size: 811, mode: os.FileMode(420), modTime: time.Unix(1792161709, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesRunHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x57\xeb\x6f\xdb\x38\x12\xff\x5c\xff\x15\x03\xb5\xb8\x26\xc0\xd9\x6a\x7b\xb8\x2f\x89\xa2\x43\xaf\xbd\x5b\x04\xcd\x0b\x49\x8a\x05\x76\xb1\x1f\x28\x71\x6c\x11\xa1\x48\x2d\x39\x4a\xa2\x35\xfc\xbf\x2f\x86\x92\x65\xc5\x8f\xbc\xd0\x5d\x04\x70\x24\x72\xe6\x37\xaf\x1f\x87\xa3\xc4\x53\xa3\x31\x1d\x01\x90\x84\xca\x21\xcc\x47\x00\x52\xf9\x4a\x8b\xe6\x00\x94\xd1\xca\xe0\xe1\x08\x20\x13\xf9\xcd\xcc\xd9\xda\xc8\x03\x30\xb6\x5b\xb3\x4e\xa2\x5b\xbd\x57\x42\x4a\x65\x66\x07\xf0\x81\xdf\x16\x23\x80\x09\x89\x4c\x23\x50\x01\xf3\x35\x8c\xb7\xd3\x7f\xf3\x5f\x2f\xe8\x73\x67\xb5\x46\x17\x04\x4b\x71\x3f\x2e\x50\xcd\x0a\x3a\x80\x8f\x9f\x3e\x54\xf7\x2c\x66\x6f\xd1\x4d\xb5\xbd\x1b\x37\x07\xd0\x4a\xf3\xea\x62\x94\xc4\x5d\x08\x89\xcf\x9d\xaa\x88\x63\x79\xb7\x37\xad\x4d\x4e\xca\x9a\xbd\xfd\x80\xf8\x6e\x2f\xfa\x55\x0a\x12\x63\xb2\xb3\x99\xc6\xa3\xf7\x64\xad\x26\x55\xbd\xff\x2d\xda\x9f\x74\xcf\x7b\xfb\x01\x70\xff\x90\x21\x3b\xa8\x44\xaa\x5b\xc8\xb5\xf0\xfe\x28\xca\xad\x21\xa1\x0c\xba\x88\x4d\x24\xc5\xa7\xe5\xc6\x7c\x0e\x6a\x0a\xc6\x12\x4c\xce\xac\xc4\xcb\xda\x4c\xae\x48\x38\x42\x39\x39\xf6\xbf\xa0\xb3\xb0\x58\xb4\x32\x83\x7d\x5b\x55\xc3\x7d\xc2\x7b\x1a\x2b\x33\xb5\xf3\x39\xa0\xf6\xd8\xab\xcc\x06\xa8\x3f\x0b\x45\x57\x24\xa8\xf6\x93\xff\xdd\x2f\x1f\xe1\xc3\x52\x5d\x0a\x33\x43\xb7\x02\x08\x98\xbe\xce\x73\xf4\x9e\x57\x8d\x6c\x51\xd7\x1e\xa2\x74\x3e\x6f\x6d\x4c\xce\x44\xc9\x8a\xf0\x76\xb9\xc2\xb1\x1c\x7f\xdd\xf4\xff\x42\x19\x83\x8c\x02\x89\xaf\x84\x59\x66\x62\xa6\x9b\xaa\x50\xb9\x35\xd0\x3f\x8d\x3d\x09\x17\x01\x29\xd2\x78\x14\x55\x41\x2f\x4a\x93\x98\xd5\xd2\xa1\x33\x43\xf8\x4b\x91\xe3\x06\xb8\x16\x19\x6a\x08\xbf\x21\x53\x3d\xa8\xab\x0d\xdc\x29\x2a\x80\x0a\x04\xc7\xaa\x99\x32\xc2\x35\x51\xca\x2f\xeb\xa6\x92\xb8\xf8\xc4\x05\x9c\xcf\x5b\xa5\xde\xe8\x57\x41\x82\x0d\x7b\x58\x30\x25\x87\xa5\x17\x1a\x1d\x41\xf8\xed\xb2\x1c\x38\x00\x90\x78\x72\xd6\xcc\x18\x7d\xc2\x0e\x33\xc7\x82\x0b\xab\xe2\xc1\x47\x58\x2c\x56\xf9\x07\x87\x95\x0d\xdc\x60\xe2\x06\xe5\x80\x74\x5d\x20\x4c\x95\xf3\x04\xca\x83\x2f\xec\x9d\x81\x0c\xb5\xbd\x3b\x04\x8f\x18\x24\x12\x01\x85\xc3\x69\xe0\x5b\x26\x02\x45\x62\x63\x25\xc6\xf3\x39\xbc\x7b\x50\xbf\xd8\xd5\x66\xb5\xda\xd7\x90\x97\xd4\x74\xb0\x7a\x8a\x6e\x16\xaa\xe8\x49\xda\x9a\x56\xcc\xf1\x24\xd1\xb9\xde\xe7\x28\x7d\xad\x66\x12\x8b\x14\xa6\xd6\x81\xd0\x1a\xec\x94\x2b\x54\x4e\xda\x68\x2a\x87\xe9\x03\x1f\xff\xcf\xd1\x2f\x8b\x10\x74\x59\x84\x2b\x11\x4b\x75\xdb\x95\xac\x85\xe5\xc5\xa9\x75\x25\x94\x48\x85\x95\x47\x51\x65\x3d\x2d\x4b\xd2\xf6\x9c\x8e\x91\x5d\x03\xe2\xdf\x71\x6e\x8d\x44\xe3\x51\x76\x92\x00\x09\xb9\x74\xf4\x26\xa1\x22\xfd\x62\xcb\x52\x18\x99\xc4\x54\x84\x15\x99\x2e\x1d\xec\xfd\xeb\x44\x7a\xcf\x92\x98\x64\x0f\x14\x93\xeb\x9f\x7b\xd0\xab\x90\x9c\x01\xe6\xe8\x0d\xc0\x06\x6e\x2b\xd5\xc3\xc2\x18\x36\x77\x4f\xd0\x30\xbd\xb2\x86\xd0\x43\x22\x96\xd1\x65\x64\x20\x23\x33\xbe\xf7\xe1\x9f\xc4\xa9\xa8\x35\x45\xbb\x79\xb2\x95\x26\xbd\xad\x96\x25\x2d\x19\xa2\xf4\xc9\x93\x3d\x55\x1a\xfb\xa3\x0c\xbe\x0b\x56\x70\xac\x3b\x52\xb3\xa5\x53\xf6\x4c\xda\x96\x3d\x74\xee\x19\xd9\x43\xe7\x1e\xc9\x1e\x3a\xf7\x37\x67\x0f\x9d\x7b\x4d\xf6\x42\xb0\x4f\x64\xaf\xa7\x3f\xc0\x96\x0e\xf6\xdf\x5a\xe9\xad\xa9\x0c\x1b\x83\x4c\xb2\xdb\xd7\x62\xd6\xf6\xf5\xb6\x0b\x32\xbb\x15\x31\xc9\xfe\x51\x2a\x29\x2d\x1d\x42\xde\x2e\x25\xb9\x95\xb8\xec\x70\x49\xbc\x7c\xeb\x1b\x77\xab\x7f\xad\x4a\x7c\xa0\x9d\xd5\x4a\x13\x74\x6a\xbd\xfc\x0b\x62\x1b\x5e\x08\xd7\xdc\x53\xdd\xb6\xd0\xda\x9d\xb5\xd8\x36\xd4\xfe\xea\xaa\x13\x7b\xf1\xe2\xa2\x07\x2d\x3e\x31\xaf\xcc\xca\x57\xd4\xa2\xd9\x96\x94\x30\x7e\x80\xe4\xed\x1d\x99\x59\xaa\x3e\xd7\xf2\x1a\x36\x0e\x3b\xe5\x33\xe7\x9f\xf5\xcd\xe7\xd0\x62\x68\x36\x4c\x4c\x4f\x99\x5d\x1b\xab\x1e\x9a\x0d\x9b\x2f\x33\x7b\xa1\xd6\x22\xed\xe1\xbe\x94\x72\x72\xe1\x2c\x0f\x57\x93\x0b\xf5\x3c\x34\x9e\xda\xc0\x87\x09\x6e\x80\xca\xb7\xc2\x4b\x83\xd9\x3e\x0a\x2e\x16\xab\x9b\x38\x51\xe9\x99\x35\x98\xc4\x6a\x75\x5a\x57\x96\x7a\xa0\xf3\xf3\xd3\x6f\x4a\xeb\x50\x8e\x9d\xe3\x56\x37\xf3\x2c\x07\xae\x9b\x56\x21\x6b\xe0\xea\xf8\xa7\x6f\xc7\x27\x27\xff\x04\xad\x6e\x50\x37\x90\x35\x7c\xc5\xc3\xf9\xf9\x29\x04\x21\x17\xa5\xe7\xe7\xa7\xff\xe9\x08\xff\x98\x1f\x57\x64\x1d\x9e\xd8\xfc\xe6\x45\x9e\xb0\x31\xcf\x9a\x70\x27\x3c\xe8\x56\x3d\x6b\x40\x18\x4b\x05\x3a\xa8\xda\x02\x45\x69\x2b\xd4\x0a\x3c\xc3\x9b\x6b\x6b\xaf\x0a\xeb\xe8\x31\x57\xee\x84\x33\xca\xcc\x7a\x5f\xf0\x5e\x11\x4a\xe8\x06\xee\x69\xad\x75\x03\x42\x97\x96\x47\xba\xb2\x44\xa9\x04\xa1\x6e\x0e\x43\x82\xf2\x6e\x96\x28\x45\x03\x19\x82\x14\x58\x5a\xa3\xfe\x60\xb8\x94\xac\xe5\x01\xd0\xd1\xa6\x9f\xbb\x18\xb6\xe6\xfd\x29\x96\x27\x8a\x5b\xf7\x96\xe3\x7b\x8a\xa5\x75\x0d\x68\xde\xdf\xc1\xec\x81\x7a\x7b\x67\x3e\x62\xb6\xf3\x6c\xc7\x85\x74\xf6\xfd\xf4\x33\x17\x78\x9b\x23\xbc\x07\xdc\x63\xd7\xbc\x78\xe4\x20\xad\xdb\x5b\x81\x7d\x0e\x1f\x7b\x7e\x00\xc5\x45\x4d\xb2\x9a\xc8\x1a\x1e\x40\x4b\x11\x24\x5e\xdd\xe0\x2b\x65\xa2\x27\x6e\x90\xae\x21\x6d\x7e\x23\x7d\x37\x95\x32\xab\x83\x79\xa1\xcc\xa0\x01\xb5\x2e\xa6\x2b\x0e\xe2\xef\x9d\x43\xdd\x99\x8e\xba\x3e\x10\x75\x4c\xfd\x81\x41\x39\x74\xf5\xce\xb0\x3a\x1e\x47\xe9\x25\x8e\x5d\x6d\xd6\x3d\x7d\x8a\x92\x83\x42\x5b\xc2\x8d\xca\xf0\xb7\xa9\x70\x28\xc0\x88\x12\x8f\x22\xc3\x32\xbd\x27\x1c\x1a\x4f\xeb\xe4\xac\x8e\xc0\xd9\x3b\x7f\x14\xfd\x6b\xf0\x99\x7a\x59\x9b\x33\x56\x58\x8b\x27\x89\x97\xa8\xe9\x0f\x4e\x14\xbb\xf7\x64\xf9\xaf\xc4\x2d\xf2\x75\x84\x7e\x90\xab\xed\xe9\x49\xe2\xf0\x5d\xc2\x2f\x49\xcc\xd1\xa6\xa3\x24\x96\xea\x36\x1d\xfd\x39\x00\x3e\xe3\x1d\x19\x90\x11\x00\x00")
 
 func assetsTemplatesRunHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -230,7 +379,47 @@ func assetsTemplatesRunHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/run.html", size: 1766, mode: os.FileMode(420), modTime: time.Unix(1467317820, 0)}
+	info := bindataFileInfo{name: "assets/templates/run.html", size: 4496, mode: os.FileMode(420), modTime: time.Unix(1792166144, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesSelfcheckHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x90\x5f\x6f\xf2\x20\x14\xc6\xef\xfb\x29\x9e\xf4\xbd\xb6\xf5\x35\x31\x31\x0e\xb9\xd9\xee\x96\x6c\xc9\xf6\x09\x10\x4e\x85\x88\xd5\x00\x3a\x17\xc2\x77\x5f\xa0\xda\x6d\xce\xb4\x21\x70\xce\xf3\x7b\xce\x1f\xe6\xc3\xa7\x25\x5e\x01\x41\x23\x56\x00\xb0\x16\x72\xbb\x71\xfb\x63\xaf\x96\xf8\xd7\xcd\xf3\xf7\x50\x01\xa9\xaa\x80\x46\xee\xfb\x20\x4c\x4f\xee\x22\xde\x89\xf3\xe4\xc3\xa8\xa0\x97\x58\x4c\xa7\x87\xf3\xa0\x64\xed\xc5\x96\x29\x73\x82\xb4\xc2\xfb\x55\x3d\xa2\x75\x2e\xc7\xf4\x8c\xbf\x93\xed\x20\x35\xc9\x2d\x6b\xf5\xac\x44\x83\x58\x5b\xba\x12\xc3\xa3\x9c\x93\xf5\xde\x29\x72\xa4\x0a\x9c\x85\x6e\xb8\xe4\xab\x46\x69\x61\x55\xff\x9f\x4f\x0f\xe7\x9a\x3f\x0e\x96\x41\xdf\x91\x2c\x8a\xe2\x8d\xfc\xd1\x86\x1b\x09\x7f\xa2\x20\x8c\xfd\x8e\xb2\xf6\x5a\x25\x46\x38\xd1\x6f\x08\x4d\xf1\xf6\x48\x69\xe4\xdc\xb5\xdd\x18\x61\x3a\x34\xaf\xcf\x48\xc9\x1f\xa5\x24\xef\x63\x04\x59\x4f\x48\x49\x65\xdc\xe5\x77\xaf\x90\xd2\x65\x8c\xfc\xb3\xa0\x78\x8c\x68\x5e\xc4\x2e\x0b\x59\x1b\xd4\x9f\xe4\xe8\x7b\x10\x3f\x4d\x3b\x61\xec\x68\x79\x17\x6c\x86\x99\x6e\xd2\xbf\x06\x1b\xe8\xbc\xfc\xb6\xac\x9a\x57\xac\x55\xe6\xc4\xab\xaf\x01\x00\x59\xed\x14\x19\x1c\x02\x00\x00")
+
+func assetsTemplatesSelfcheckHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesSelfcheckHtml,
+		"assets/templates/selfcheck.html",
+	)
+}
+
+func assetsTemplatesSelfcheckHtml() (*asset, error) {
+	bytes, err := assetsTemplatesSelfcheckHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/selfcheck.html", size: 540, mode: os.FileMode(420), modTime: time.Unix(1792159287, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesSettingsHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x53\xcd\x8a\xdb\x30\x10\xbe\xfb\x29\x06\xf7\x1c\x0b\x16\x7a\xd9\x4e\x54\x0a\xed\xa9\x90\x4b\xa1\x77\xd9\x1a\xdb\x62\x55\x29\x2b\xc9\xde\x16\xa3\x77\x2f\x52\x2c\xdb\x09\xed\x69\x09\x98\x68\xac\xf9\xfe\x66\x8c\x3e\xfc\xd1\xc4\x2b\x80\x30\xc2\x52\x01\x00\xb4\xa2\x7b\x19\x9c\x9d\x8c\x7c\x86\x0f\xfd\xc7\xf4\xfb\x54\x01\xc4\x0a\xd9\x7a\x19\xa5\x9a\xa1\xd3\xc2\xfb\x73\xdd\x59\x13\x84\x32\xe4\xea\x04\x82\xe3\x13\xff\x41\x21\x28\x33\x78\x64\xe3\x53\xae\x4d\xba\x5c\x36\x62\x06\x23\xe6\xd3\x55\x69\xed\x73\x03\x00\x6a\xb5\x2c\xa0\x7a\xa0\x57\x68\xbe\x2b\x23\xa1\xee\xf4\xe4\x03\xb9\x1a\x62\x2c\x9d\xa2\x0b\x6a\xa6\x7a\x59\x80\x8c\x84\x18\x39\x0a\x18\x1d\xf5\xe7\x54\x6a\x85\x27\x88\x91\xf9\x95\xf9\x24\x55\xdf\xd7\x7c\x85\x81\x52\x46\x26\x38\x32\xad\xfe\xcb\xeb\xc9\x7b\x65\xcd\xfb\x78\x3f\xbf\x28\x23\xcf\x05\x8a\xaf\x7f\x60\x16\x4e\x89\x56\xd3\x9d\x0a\x64\x93\x4e\x6a\xf0\x9a\x9e\x00\xb7\x20\x9a\xaf\xaa\xef\xc9\x41\x8c\xb9\x08\x80\xfe\x2a\x4c\x51\x14\xe8\x77\x38\x49\x61\x86\x14\x39\xfa\xe0\xac\x19\xf8\xb2\x1c\xba\xd2\x9c\x72\x75\x73\x0e\x49\x18\x39\x68\x29\xbc\x11\x19\x30\x56\x92\x6f\x90\x25\xdc\x8d\x99\xb4\xa7\x4c\x7f\x49\x6f\x77\xf6\x2f\x5a\xef\x40\x62\x70\x44\x20\x3a\x67\xbd\x4f\x72\x35\x99\xbd\x61\xc5\xbd\x03\xdc\x4d\x28\x7e\xb1\xe0\x26\x63\x94\x19\x6e\x37\xa1\xb3\x93\x96\xd0\x12\xbc\x4e\xe4\x14\xc9\x06\xd9\x3a\x9d\x2d\xf0\x94\x0e\xcb\xf1\x2c\x0b\xb8\x64\x1b\x9a\x6f\xce\x59\xb7\x29\x3c\x6e\xa3\xd0\xe4\x02\xe4\xe7\xe9\x4d\xb8\x44\x55\xf3\xc4\x95\xf4\x34\x17\xf1\x2b\xcd\xeb\x39\x1f\x32\x48\x4e\x4b\xaa\x99\x57\xf7\x94\xcb\xf2\x98\x04\x86\x34\xbd\x6d\x08\xf9\x90\x4b\xa7\xd6\x3a\x49\x8e\xe4\x7a\xec\xac\x91\x64\x3c\xc9\xb2\xdf\xc1\xf1\x12\x41\x18\xcb\xd7\x81\x2c\x8c\xa5\xbc\x1b\x3b\xf0\xed\x1d\xff\xda\xb8\x64\x89\x25\x17\x10\xe3\xc1\x60\xb6\x23\xf8\x03\xf8\xe6\x2a\x45\x59\xd4\xec\xa4\xe5\x7b\xdd\x79\x31\xb8\xc7\x4d\x2c\xc6\xd7\xc5\xdb\x50\x0b\x4d\x6a\x92\x1c\x3b\x2b\x89\x1f\xa2\x46\x96\x2b\xc8\x82\xdc\x6f\xee\xd4\x3f\x85\x9e\xee\x0d\xdf\x70\x36\x2f\x0f\x6d\x07\x27\x0f\x5e\xb6\x37\xc8\xf2\x18\xee\x07\x8a\x4c\xaa\x99\x57\x7f\x07\x00\x74\xb6\x64\x36\xed\x04\x00\x00")
+
+func assetsTemplatesSettingsHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesSettingsHtml,
+		"assets/templates/settings.html",
+	)
+}
+
+func assetsTemplatesSettingsHtml() (*asset, error) {
+	bytes, err := assetsTemplatesSettingsHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/settings.html", size: 1261, mode: os.FileMode(420), modTime: time.Unix(1792161709, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -288,13 +477,22 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"assets/css/default.css": assetsCssDefaultCss,
+	"assets/favicon.ico": assetsFaviconIco,
+	"assets/js/palette.js": assetsJsPaletteJs,
 	"assets/templates/cluster.html": assetsTemplatesClusterHtml,
+	"assets/templates/confirm.html": assetsTemplatesConfirmHtml,
 	"assets/templates/error.html": assetsTemplatesErrorHtml,
+	"assets/templates/events.html": assetsTemplatesEventsHtml,
+	"assets/templates/follow.html": assetsTemplatesFollowHtml,
 	"assets/templates/layout.html": assetsTemplatesLayoutHtml,
+	"assets/templates/leases.html": assetsTemplatesLeasesHtml,
 	"assets/templates/log.html": assetsTemplatesLogHtml,
 	"assets/templates/node.html": assetsTemplatesNodeHtml,
 	"assets/templates/notfound.html": assetsTemplatesNotfoundHtml,
+	"assets/templates/ports.html": assetsTemplatesPortsHtml,
 	"assets/templates/run.html": assetsTemplatesRunHtml,
+	"assets/templates/selfcheck.html": assetsTemplatesSelfcheckHtml,
+	"assets/templates/settings.html": assetsTemplatesSettingsHtml,
 }
 
 // AssetDir returns the file names below a certain
@@ -341,14 +539,25 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"css": &bintree{nil, map[string]*bintree{
 			"default.css": &bintree{assetsCssDefaultCss, map[string]*bintree{}},
 		}},
+		"favicon.ico": &bintree{assetsFaviconIco, map[string]*bintree{}},
+		"js": &bintree{nil, map[string]*bintree{
+			"palette.js": &bintree{assetsJsPaletteJs, map[string]*bintree{}},
+		}},
 		"templates": &bintree{nil, map[string]*bintree{
 			"cluster.html": &bintree{assetsTemplatesClusterHtml, map[string]*bintree{}},
+			"confirm.html": &bintree{assetsTemplatesConfirmHtml, map[string]*bintree{}},
 			"error.html": &bintree{assetsTemplatesErrorHtml, map[string]*bintree{}},
+			"events.html": &bintree{assetsTemplatesEventsHtml, map[string]*bintree{}},
+			"follow.html": &bintree{assetsTemplatesFollowHtml, map[string]*bintree{}},
 			"layout.html": &bintree{assetsTemplatesLayoutHtml, map[string]*bintree{}},
+			"leases.html": &bintree{assetsTemplatesLeasesHtml, map[string]*bintree{}},
 			"log.html": &bintree{assetsTemplatesLogHtml, map[string]*bintree{}},
 			"node.html": &bintree{assetsTemplatesNodeHtml, map[string]*bintree{}},
 			"notfound.html": &bintree{assetsTemplatesNotfoundHtml, map[string]*bintree{}},
+			"ports.html": &bintree{assetsTemplatesPortsHtml, map[string]*bintree{}},
 			"run.html": &bintree{assetsTemplatesRunHtml, map[string]*bintree{}},
+			"selfcheck.html": &bintree{assetsTemplatesSelfcheckHtml, map[string]*bintree{}},
+			"settings.html": &bintree{assetsTemplatesSettingsHtml, map[string]*bintree{}},
 		}},
 	}},
 }}
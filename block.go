@@ -0,0 +1,222 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// faultCgroupRoot is the cgroup v2 hierarchy under which each node's runs
+// are placed with -allow-faults, so that iptables can tell the traffic of
+// one node from that of the others.
+const faultCgroupRoot = "/sys/fs/cgroup"
+
+// faultCgroup returns the path, relative to faultCgroupRoot, of the cgroup
+// the node's runs are placed in. Node ports are unique on the host, so the
+// port tells the nodes of several roachdemos apart.
+func (n *node) faultCgroup() string {
+	return fmt.Sprintf("roachdemo/node-%d", n.Port)
+}
+
+// checkFaults returns an error if traffic from the node can't be blocked.
+func (n *node) checkFaults() error {
+	if !*allowFaults {
+		return fmt.Errorf("network faults are disabled; restart roachdemo with -allow-faults")
+	}
+	if runtime.GOOS != "linux" {
+		return fmt.Errorf("network faults require Linux, not %s", runtime.GOOS)
+	}
+	if n.Container != "" {
+		return fmt.Errorf("node %s runs in a container and its traffic can't be blocked", n.Name)
+	}
+	if n.MemLimit != "" {
+		return fmt.Errorf("node %s has a memory limit, whose systemd scope takes the place of the cgroup its traffic is matched by", n.Name)
+	}
+	if os.Geteuid() != 0 {
+		return fmt.Errorf("network faults require running roachdemo as root")
+	}
+	if _, err := os.Stat(filepath.Join(faultCgroupRoot, "cgroup.controllers")); err != nil {
+		return fmt.Errorf("network faults require cgroup v2 mounted at %s", faultCgroupRoot)
+	}
+	if _, err := exec.LookPath("iptables"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// faultCgroupArgs returns args modified to run the process in the node's
+// cgroup, which is created if needed. The shell moves itself into the
+// cgroup before exec'ing the process, so that all of the process's sockets
+// belong to it.
+func (n *node) faultCgroupArgs(args []string) ([]string, error) {
+	dir := filepath.Join(faultCgroupRoot, n.faultCgroup())
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return append([]string{
+		"/bin/sh", "-c", `echo $$ > "$0" && exec "$@"`, filepath.Join(dir, "cgroup.procs"),
+	}, args...), nil
+}
+
+// nodePorts returns the ports the node listens on according to its args
+// (see argPorts): its RPC port and, if set, its separate SQL and HTTP ports.
+func nodePorts(args []string) []int {
+	var ports []int
+	seen := map[int]bool{}
+	for _, u := range argPorts(args) {
+		if u.Port > 0 && !seen[u.Port] {
+			seen[u.Port] = true
+			ports = append(ports, u.Port)
+		}
+	}
+	sort.Ints(ports)
+	return ports
+}
+
+// blockRule returns the iptables rule, excluding the command, dropping the
+// packets sent from the node's cgroup to the ports.
+func (n *node) blockRule(ports []int) []string {
+	return []string{
+		"OUTPUT", "-p", "tcp",
+		"-m", "cgroup", "--path", n.faultCgroup(),
+		"-m", "multiport", "--dports", formatPorts(ports),
+		"-m", "comment", "--comment", "roachdemo block " + n.Name,
+		"-j", "DROP",
+	}
+}
+
+// iptables runs iptables with the specified action, -A or -D, and rule.
+func iptables(action string, rule []string) error {
+	out, err := runCommand(0, nil, "iptables", append([]string{action}, rule...)...)
+	if err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("%s: %s", err, msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// block drops the node's outbound traffic to the peer's ports, leaving the
+// peer's traffic to the node alone. Blocks persist across restarts of both
+// nodes as long as the peer keeps its ports.
+func (n *node) block(peer *node) error {
+	if peer == n {
+		return fmt.Errorf("node %s can't be blocked from itself", n.Name)
+	}
+	if _, ok := n.Blocked[peer.Name]; ok {
+		return fmt.Errorf("node %s is already blocked from %s", n.Name, peer.Name)
+	}
+	ports := nodePorts(peer.Args)
+	if len(ports) == 0 {
+		return fmt.Errorf("no ports found in the args of node %s", peer.Name)
+	}
+	if err := os.MkdirAll(filepath.Join(faultCgroupRoot, n.faultCgroup()), 0755); err != nil {
+		return err
+	}
+	if err := iptables("-A", n.blockRule(ports)); err != nil {
+		return err
+	}
+	if n.Blocked == nil {
+		n.Blocked = map[string][]int{}
+	}
+	n.Blocked[peer.Name] = ports
+	return nil
+}
+
+// unblock removes the block of the node's traffic to the peer.
+func (n *node) unblock(peer string) error {
+	ports, ok := n.Blocked[peer]
+	if !ok {
+		return fmt.Errorf("node %s is not blocked from %s", n.Name, peer)
+	}
+	if err := iptables("-D", n.blockRule(ports)); err != nil {
+		return err
+	}
+	delete(n.Blocked, peer)
+	return nil
+}
+
+// unblockAll removes all of the node's blocks, logging failures.
+func (n *node) unblockAll() {
+	for peer := range n.Blocked {
+		if err := n.unblock(peer); err != nil {
+			log.Printf("node %s: unable to unblock %s: %s", n.Name, peer, err)
+		}
+	}
+}
+
+// BlockedPeers returns the names of the peers the node is blocked from,
+// sorted.
+func (n *node) BlockedPeers() []string {
+	peers := make([]string, 0, len(n.Blocked))
+	for peer := range n.Blocked {
+		peers = append(peers, peer)
+	}
+	sort.Strings(peers)
+	return peers
+}
+
+// blockNode drops the node's outbound traffic to the node given by the
+// "peer" form value, simulating an asymmetric partition between the two.
+func (c *cluster) blockNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+	if err := t.checkFaults(); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, err.Error())
+		return
+	}
+	peer, ok := c.Nodes[req.FormValue("peer")]
+	if !ok {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("peer %q not found", req.FormValue("peer")))
+		return
+	}
+
+	if err := t.block(peer); err != nil {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, err.Error())
+		return
+	}
+	c.events.add(t.Name, "blocked traffic to node %s (ports %s)", peer.Name, formatPorts(t.Blocked[peer.Name]))
+
+	redirect(rw, req)
+}
+
+// unblockNode removes the block of the node's traffic to the node given by
+// the "peer" form value.
+func (c *cluster) unblockNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	peer := req.FormValue("peer")
+	if err := t.unblock(peer); err != nil {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, err.Error())
+		return
+	}
+	c.events.add(t.Name, "unblocked traffic to node %s", peer)
+
+	redirect(rw, req)
+}
+
+// formatPorts formats ports as a comma-separated list.
+func formatPorts(ports []int) string {
+	s := make([]string, len(ports))
+	for i, p := range ports {
+		s[i] = strconv.Itoa(p)
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNodePorts(t *testing.T) {
+	testCases := []struct {
+		args  []string
+		ports []int
+	}{
+		{[]string{"cockroach", "start", "--port=26257", "--http-addr=localhost:8080"}, []int{8080, 26257}},
+		{[]string{"cockroach", "start", "--port", "26257", "--sql-addr", "localhost:26357"}, []int{26257, 26357}},
+		{[]string{"cockroach", "start", "--listen-addr=localhost:26257", "--http-port=26257"}, []int{26257}},
+		{[]string{"cockroach", "start"}, nil},
+	}
+	for _, tc := range testCases {
+		if ports := nodePorts(tc.args); !reflect.DeepEqual(ports, tc.ports) {
+			t.Errorf("%v: expected ports %v, got %v", tc.args, tc.ports, ports)
+		}
+	}
+}
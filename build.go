@@ -0,0 +1,96 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// cockroachBuild is the build of a cockroach binary as reported by
+// "cockroach version".
+type cockroachBuild struct {
+	Tag    string `json:"tag"`
+	Commit string `json:"commit,omitempty"`
+	Time   string `json:"time,omitempty"`
+}
+
+// String describes the build, e.g. "v21.1.0 (0123456789ab)".
+func (b cockroachBuild) String() string {
+	if b.Commit == "" {
+		return b.Tag
+	}
+	commit := b.Commit
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	return b.Tag + " (" + commit + ")"
+}
+
+// parseCockroachBuild parses the output of "cockroach version".
+func parseCockroachBuild(out string) cockroachBuild {
+	b := cockroachBuild{Tag: "unknown version"}
+	for _, line := range strings.Split(out, "\n") {
+		i := strings.IndexByte(line, ':')
+		if i < 0 {
+			continue
+		}
+		value := strings.TrimSpace(line[i+1:])
+		switch strings.TrimSpace(line[:i]) {
+		case "Build Tag":
+			b.Tag = value
+		case "Build Commit ID":
+			b.Commit = value
+		case "Build Time":
+			b.Time = value
+		}
+	}
+	return b
+}
+
+// cachedBuild is the build of a binary, valid as long as the binary has the
+// same size and modification time.
+type cachedBuild struct {
+	build   *cockroachBuild
+	size    int64
+	modTime time.Time
+}
+
+// binaryBuild returns the build of the cockroach binary at path, or nil if
+// it can't be determined. Builds are cached per path, and a binary replaced
+// by another build is detected by its size and modification time.
+func (v *versionCache) binaryBuild(path string) *cockroachBuild {
+	resolved, err := exec.LookPath(path)
+	if err != nil {
+		return nil
+	}
+	fi, err := os.Stat(resolved)
+	if err != nil {
+		return nil
+	}
+
+	v.buildsMu.Lock()
+	defer v.buildsMu.Unlock()
+	if b, ok := v.builds[path]; ok && b.size == fi.Size() && b.modTime.Equal(fi.ModTime()) {
+		return b.build
+	}
+	var build *cockroachBuild
+	if out, err := runCommand(0, nil, resolved, "version"); err == nil {
+		b := parseCockroachBuild(string(out))
+		build = &b
+	}
+	if v.builds == nil {
+		v.builds = map[string]cachedBuild{}
+	}
+	v.builds[path] = cachedBuild{build: build, size: fi.Size(), modTime: fi.ModTime()}
+	return build
+}
+
+// Build returns the build of the binary of the node's latest run, or nil if
+// it is unknown.
+func (n *node) Build() *cockroachBuild {
+	if len(n.Runs) == 0 {
+		return nil
+	}
+	return n.Runs[len(n.Runs)-1].Build
+}
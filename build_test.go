@@ -0,0 +1,60 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeFakeCockroach writes a script reporting the build tag to "version"
+// and exiting otherwise.
+func writeFakeCockroach(t *testing.T, dir, name, tag string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	script := "#!/bin/sh\n[ \"$1\" = version ] && echo 'Build Tag: " + tag + "'\nexit 0\n"
+	if err := ioutil.WriteFile(path, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRunBuildOfRunBinary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	current := writeFakeCockroach(t, dir, "current", "v2.0.0")
+	previous := writeFakeCockroach(t, dir, "previous", "v1.0.0")
+
+	n := newNode("1", []string{current, "start"}, nil, false, "", "", "", "")
+	n.startRun([]string{previous, "start"}, nil, nil)
+	waitRun(t, n.Runs[0])
+	if b := n.Runs[0].Build; b == nil || b.Tag != "v1.0.0" {
+		t.Fatalf("expected the build of the binary run, got %v", b)
+	}
+}
+
+func TestBinaryBuildDoesNotWaitOnVersions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bin := writeFakeCockroach(t, dir, "cockroach", "v1.0.0")
+
+	versions.mu.Lock()
+	defer versions.mu.Unlock()
+	done := make(chan *cockroachBuild)
+	go func() { done <- versions.binaryBuild(bin) }()
+	select {
+	case b := <-done:
+		if b == nil || b.Tag != "v1.0.0" {
+			t.Fatalf("expected build v1.0.0, got %v", b)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("binaryBuild waited on the version cache's mutex")
+	}
+}
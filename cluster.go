@@ -1,18 +1,32 @@
 package main
 
 import (
+	"encoding/csv"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 const basePort = 26257
 const dataDir = "cockroach-data"
 
+// dockerStoreDir is where a node's data directory is mounted in its
+// container.
+const dockerStoreDir = "/cockroach/cockroach-data"
+
+// dockerHostAlias is the name containers use to reach the docker host.
+const dockerHostAlias = "host.docker.internal"
+
 var cockroachBin = func() string {
 	bin := "./cockroach"
 	if _, err := os.Stat(bin); err == nil {
@@ -21,64 +35,387 @@ var cockroachBin = func() string {
 	return "cockroach"
 }()
 
+// cockroachVersion returns the build tag reported by "<bin> version".
+func cockroachVersion(bin string) (string, error) {
+	out, err := runCommand(0, nil, bin, "version")
+	if err != nil {
+		return "", err
+	}
+	return parseCockroachBuild(string(out)).Tag, nil
+}
+
 type cluster struct {
-	Nodes      map[string]*node
+	Nodes map[string]*node
+	// NextNodeID is the ID of the next node to be created (see nextNodeID).
+	NextNodeID int
 	NextPort   int
+	// NextSQLPort is the next port allocated to a node's SQL listener, or 0
+	// if nodes serve SQL on their RPC port.
+	NextSQLPort int
+	// Tenants are the tenant SQL servers, keyed by tenant ID, and
+	// NextTenantPort is the next port allocated to one of them.
+	Tenants        map[string]*node
+	NextTenantPort int
+
 	args       []string
+	fileArgs   []string
 	attrs      perNodeAttribute
 	localities perNodeAttribute
+	stores     perNodeAttribute
+	maxOffsets perNodeAttribute
+	// host is the address nodes listen on and advertiseHost is the address
+	// they advertise to each other and which is used for their URLs. The two
+	// differ only when listening on an unspecified address such as 0.0.0.0.
+	host          string
+	advertiseHost string
+	// httpHost is the address the nodes' admin UIs listen on and
+	// httpAdvertiseHost is the corresponding address used for their URLs.
+	httpHost          string
+	httpAdvertiseHost string
+	// Vmodule is the --vmodule setting applied to all nodes.
+	Vmodule string
+	events  eventLog
+	confirm confirmTokens
+	// Demo is the "cockroach demo" process run in -demo mode.
+	Demo *demoProcess
+	// schedule is the scheduled stop-all and start-all, if any.
+	schedule clusterSchedule
+	// hostUsage is the host machine's resource usage, sampled if -host-stats
+	// is set.
+	hostUsage hostStats
+	// initProgress is the progress of initializing the cluster with
+	// -auto-init.
+	initProgress clusterInit
+	// recorder records the actions taken on the cluster if -record is set,
+	// and replay is the replay of a recording, if any.
+	recorder actionRecorder
+	replay   clusterReplay
+	// quit is closed when a client requests that roachdemo shut down.
+	quit     chan struct{}
+	quitOnce sync.Once
 }
 
-func newCluster(args []string, attrs, localities perNodeAttribute) *cluster {
+func newCluster(args []string, attrs, localities, stores, maxOffsets perNodeAttribute, host, httpHost string) *cluster {
+	if httpHost == "" {
+		httpHost = host
+	}
 	return &cluster{
-		Nodes:      map[string]*node{},
-		NextPort:   basePort,
-		args:       args,
-		attrs:      attrs,
-		localities: localities,
+		Nodes:             map[string]*node{},
+		NextNodeID:        1,
+		NextPort:          basePort,
+		NextSQLPort:       *sqlBasePort,
+		Tenants:           map[string]*node{},
+		NextTenantPort:    tenantBasePort,
+		args:              args,
+		attrs:             attrs,
+		localities:        localities,
+		stores:            stores,
+		maxOffsets:        maxOffsets,
+		host:              host,
+		advertiseHost:     advertiseHostFor(host),
+		httpHost:          httpHost,
+		httpAdvertiseHost: advertiseHostFor(httpHost),
+		quit:              make(chan struct{}),
+	}
+}
+
+// advertiseHostFor returns the address other processes should use to reach
+// a listener on host, which differs from host only when it is an unspecified
+// address such as 0.0.0.0.
+func advertiseHostFor(host string) string {
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		if h, err := os.Hostname(); err == nil {
+			return h
+		}
+		return "localhost"
+	}
+	return host
+}
+
+// validateHost verifies that host is either an IP address or a resolvable
+// host name.
+func validateHost(host string) error {
+	if host == "" {
+		return fmt.Errorf("host must not be empty")
+	}
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	if _, err := net.LookupHost(host); err != nil {
+		return fmt.Errorf("invalid host %q: %s", host, err)
 	}
+	return nil
 }
 
 func (c *cluster) close() {
-	for _, t := range c.Nodes {
-		if t.Active != nil && t.Active.Cmd != nil && t.Active.Cmd.Process != nil {
-			t.Active.Cmd.Process.Kill()
+	if c.Demo != nil {
+		c.Demo.stop()
+	}
+	for _, t := range c.sortedTenants() {
+		if r := t.Active(); r != nil {
+			r.stop()
+		}
+	}
+	for _, t := range c.sortedNodes() {
+		if r := t.Active(); r != nil {
+			r.stop()
+		}
+		t.unblockAll()
+	}
+}
+
+// quitServer asks roachdemo to stop all nodes and exit. The request is
+// acknowledged before the shutdown begins.
+func (c *cluster) quitServer(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	if !*allowQuit {
+		rw.WriteHeader(http.StatusForbidden)
+		renderError(rw, "quitting is disabled; restart roachdemo with -allow-quit")
+		return
+	}
+	if req.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		renderError(rw, "quit must be requested with POST")
+		return
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+	fmt.Fprintln(rw, "shutting down")
+	c.quitOnce.Do(func() { close(c.quit) })
+}
+
+// nextNodeID returns the ID of the next node to be created. IDs only ever
+// increase, so that the ID of a removed node, whose data directory is left
+// in place, isn't reused along with the directory.
+func (c *cluster) nextNodeID() int {
+	return c.NextNodeID
+}
+
+// sortNodes orders nodes by numeric ID, so that node 10 follows node 9
+// rather than node 1.
+func sortNodes(nodes []*node) []*node {
+	sort.Slice(nodes, func(i, j int) bool {
+		a, aerr := strconv.Atoi(nodes[i].Name)
+		b, berr := strconv.Atoi(nodes[j].Name)
+		if aerr != nil || berr != nil {
+			return nodes[i].Name < nodes[j].Name
 		}
+		return a < b
+	})
+	return nodes
+}
+
+// sortedNodes returns the cluster's nodes ordered by ID. Anything which shows
+// or acts on all of the nodes should iterate over them in this order rather
+// than over the Nodes map.
+func (c *cluster) sortedNodes() []*node {
+	nodes := make([]*node, 0, len(c.Nodes))
+	for _, t := range c.Nodes {
+		nodes = append(nodes, t)
+	}
+	return sortNodes(nodes)
+}
+
+// sortedTenants returns the cluster's tenant SQL servers ordered by tenant
+// ID.
+func (c *cluster) sortedTenants() []*node {
+	tenants := make([]*node, 0, len(c.Tenants))
+	for _, t := range c.Tenants {
+		tenants = append(tenants, t)
+	}
+	return sortNodes(tenants)
+}
+
+// NodesLeft returns the number of nodes which can still be added before their
+// ports would exceed -max-port, or -1 if there is no limit.
+func (c *cluster) NodesLeft() int {
+	if *maxPort <= 0 {
+		return -1
+	}
+	n := (*maxPort - c.NextPort + 1) / 2
+	if n < 0 {
+		n = 0
+	}
+	return n
+}
+
+// sqlPortsLeft returns the number of nodes which can still be allocated a
+// SQL port from -sql-port before the SQL ports would run into the node port
+// range, which starts at basePort, or beyond the highest port. It returns -1
+// if nodes serve SQL on their RPC port.
+func (c *cluster) sqlPortsLeft() int {
+	if c.NextSQLPort == 0 {
+		return -1
+	}
+	limit := 65535
+	if c.NextSQLPort < basePort {
+		limit = basePort - 1
 	}
+	n := limit - c.NextSQLPort + 1
+	if n < 0 {
+		n = 0
+	}
+	return n
+}
+
+// portsAvailable returns an error if adding count nodes would allocate ports
+// beyond -max-port, or SQL ports colliding with the node port range.
+func (c *cluster) portsAvailable(count int) error {
+	if left := c.NodesLeft(); left >= 0 && count > left {
+		return fmt.Errorf("adding %d nodes would allocate ports beyond -max-port=%d; there is room for %d more",
+			count, *maxPort, left)
+	}
+	if left := c.sqlPortsLeft(); left >= 0 && count > left {
+		return fmt.Errorf("adding %d nodes would allocate SQL ports from -sql-port=%d overlapping the node ports; "+
+			"there is room for %d more", count, *sqlBasePort, left)
+	}
+	return nil
 }
 
 var envRE = regexp.MustCompile(`(COCKROACH_[^=]+|GO[^=]+)=(.*)`)
 
-func (c *cluster) newNode() *node {
-	id := len(c.Nodes) + 1
+// newNode creates the next node and starts it. If the node's first run
+// can't be started, the node is discarded rather than left in the cluster
+// (see discardNode).
+func (c *cluster) newNode() (*node, error) {
+	t, err := c.createNode()
+	if err != nil {
+		return nil, err
+	}
+	// The node only becomes a service once it has started, so that a run
+	// which fails to start isn't restarted while the node is discarded.
+	t.start()
+	if err := t.startError(); err != nil {
+		c.discardNode(t)
+		return nil, fmt.Errorf("unable to start node %s: %s", t.Name, err)
+	}
+	t.startMu.Lock()
+	t.Service = true
+	t.startMu.Unlock()
+	return t, nil
+}
+
+// createNode creates the next node without starting it.
+func (c *cluster) createNode() (*node, error) {
+	return c.createNodeID(c.nextNodeID())
+}
+
+// nodeFlags returns the flags roachdemo sets to place a node: its addresses,
+// store and join target. The SQL address is left out if sqlAddr is empty.
+// The managedFlags are derived from them.
+func nodeFlags(host, advertiseHost string, port int, httpAddr, store, sqlAddr, join string) []string {
+	args := []string{
+		fmt.Sprintf("--host=%s", host),
+		fmt.Sprintf("--advertise-host=%s", advertiseHost),
+		fmt.Sprintf("--port=%d", port),
+		fmt.Sprintf("--http-addr=%s", httpAddr),
+		fmt.Sprintf("--store=%s", store),
+	}
+	if sqlAddr != "" {
+		args = append(args, fmt.Sprintf("--sql-addr=%s", sqlAddr))
+	}
+	return append(args, fmt.Sprintf("--join=%s", join))
+}
+
+// createNodeID creates the node with the specified id without starting it.
+// Nothing is left behind if it fails.
+func (c *cluster) createNodeID(id int) (*node, error) {
 	name := fmt.Sprintf("%d", id)
 	dir := filepath.Join(dataDir, name)
 	logdir := filepath.Join(dir, "logs")
-	if err := os.MkdirAll(logdir, 0755); err != nil {
-		log.Fatal(err)
+	_, err := os.Stat(dir)
+	freshDir := os.IsNotExist(err)
+	var absDir string
+	if err == nil || freshDir {
+		err = os.MkdirAll(logdir, 0755)
+	}
+	if err == nil && *dockerImage != "" {
+		absDir, err = filepath.Abs(dir)
+	}
+	if err != nil {
+		if freshDir {
+			os.RemoveAll(dir)
+		}
+		return nil, fmt.Errorf("unable to create node %s: %s", name, err)
 	}
 
 	port := c.NextPort
 	httpPort := c.NextPort + 1
 	c.NextPort += 2
+	sqlPort := port
+	if c.NextSQLPort != 0 {
+		sqlPort = c.NextSQLPort
+		c.NextSQLPort++
+	}
 
-	args := []string{
-		cockroachBin,
-		"start",
-		"--insecure",
-		"--host=localhost",
-		fmt.Sprintf("--port=%d", port),
-		fmt.Sprintf("--http-port=%d", httpPort),
-		fmt.Sprintf("--store=%s", dir),
-		fmt.Sprintf("--cache=256MiB"),
-		// fmt.Sprintf("--logtostderr"),
+	host, advertiseHost, httpHost, store := c.host, c.advertiseHost, c.httpHost, dir
+	urlHost, joinHost := c.httpAdvertiseHost, ""
+	if *loopbackAliases && *dockerImage == "" {
+		if addr, ok := loopbackAlias(id); ok {
+			host, advertiseHost, httpHost, urlHost = addr, addr, addr, addr
+			if t, ok := c.Nodes[bootstrapNode]; ok {
+				joinHost = t.Host
+			}
+		}
+	}
+	storeSpec, customStore := c.stores[id]
+	var container string
+	var args []string
+	if *dockerImage != "" {
+		// Inside the container the node listens on all interfaces and
+		// reaches its peers through the ports published on the docker host.
+		container = fmt.Sprintf("roachdemo-%s", name)
+		host, httpHost, store = "0.0.0.0", "0.0.0.0", dockerStoreDir
+		if customStore && isMemStore(storeSpec) {
+			store = storeSpec
+		}
+		if advertiseHost == "localhost" {
+			advertiseHost = dockerHostAlias
+		}
+		args = []string{
+			"docker",
+			"run",
+			"--rm",
+			fmt.Sprintf("--name=%s", container),
+			fmt.Sprintf("--add-host=%s:host-gateway", dockerHostAlias),
+			fmt.Sprintf("--publish=%d:%d", port, port),
+			fmt.Sprintf("--publish=%d:%d", httpPort, httpPort),
+		}
+		if sqlPort != port {
+			args = append(args, fmt.Sprintf("--publish=%d:%d", sqlPort, sqlPort))
+		}
+		args = append(args,
+			fmt.Sprintf("--volume=%s:%s", absDir, dockerStoreDir),
+			*dockerImage,
+		)
+	} else {
+		args = []string{cockroachBin}
+		if *raceBinary != "" {
+			args[0] = *raceBinary
+		}
+		if customStore {
+			store = storeSpec
+		}
 	}
 
+	var sqlAddr string
+	if sqlPort != port {
+		sqlAddr = net.JoinHostPort(host, fmt.Sprint(sqlPort))
+	}
 	// NB: always specify the join flag, even for the
 	// first node, to avoid cockroach insisting we use
 	// start-single-node instead, which we don't want
 	// to.
-	args = append(args, fmt.Sprintf("--join=localhost:%d", basePort))
+	if joinHost == "" {
+		joinHost = advertiseHost
+	}
+	args = append(args, "start", "--insecure")
+	args = append(args, nodeFlags(host, advertiseHost, port,
+		net.JoinHostPort(httpHost, fmt.Sprint(httpPort)), store, sqlAddr,
+		fmt.Sprintf("%s:%d", joinHost, basePort))...)
+	args = append(args,
+		fmt.Sprintf("--cache=256MiB"),
+		// fmt.Sprintf("--logtostderr"),
+	)
 	attributes, found := c.attrs[id]
 	if found {
 		args = append(args, fmt.Sprintf("--attrs=%s", attributes))
@@ -87,7 +424,18 @@ func (c *cluster) newNode() *node {
 	if found {
 		args = append(args, fmt.Sprintf("--locality=%s", locality))
 	}
+	offset, found := c.maxOffsets[id]
+	if !found {
+		offset = *maxOffset
+	}
+	if offset != "" {
+		args = append(args, fmt.Sprintf("--max-offset=%s", offset))
+	}
 	args = append(args, c.args...)
+	args = append(args, c.fileArgs...)
+	if c.Vmodule != "" {
+		args = append(args, fmt.Sprintf("--vmodule=%s", c.Vmodule))
+	}
 
 	env := make(map[string]string)
 	for _, val := range os.Environ() {
@@ -98,11 +446,86 @@ func (c *cluster) newNode() *node {
 		env[m[1]] = m[2]
 	}
 
-	node := newNode(name, args, env, true, filepath.Join(logdir, "${RUN}.stdout"),
+	node := newNode(name, args, env, false, filepath.Join(logdir, "${RUN}.stdout"),
 		filepath.Join(logdir, "${RUN}.stderr"), attributes, locality)
-	node.URL = fmt.Sprintf("http://localhost:%d", httpPort)
+	// All of the variables passed to newNode came from our environment.
+	for name, source := range node.EnvSources {
+		if source == envOverride {
+			node.EnvSources[name] = envInherited
+		}
+	}
+	node.URL = fmt.Sprintf("http://%s", net.JoinHostPort(urlHost, fmt.Sprint(httpPort)))
+	node.Host = advertiseHost
+	node.Port = port
+	node.SQLPort = sqlPort
+	node.HTTPPort = httpPort
+	node.Dir = dir
+	node.Store = store
+	node.Container = container
+	node.ReadyCommand = *readyCmd
+	node.PreStartHook = *preStartHook
+	node.PostStopHook = *postStopHook
+	node.freshDir = freshDir
+	if err := node.loadNotes(); err != nil {
+		log.Printf("node %s: unable to load notes: %s", node.Name, err)
+	}
 	c.Nodes[node.Name] = node
-	return node
+	if id >= c.NextNodeID {
+		c.NextNodeID = id + 1
+	}
+	statNodesCreated.Add(1)
+	return node, nil
+}
+
+// discardNode undoes the creation of a node which failed to start: it is
+// removed from the cluster along with its store spec, its ID and ports are
+// released if no node was created after it, and its data directory is
+// removed unless it was left by a previous roachdemo.
+func (c *cluster) discardNode(t *node) {
+	t.stop()
+	delete(c.Nodes, t.Name)
+	if id, err := strconv.Atoi(t.Name); err == nil {
+		delete(c.stores, id)
+		if c.NextNodeID == id+1 {
+			c.NextNodeID = id
+		}
+	}
+	if c.NextPort == t.Port+2 {
+		c.NextPort = t.Port
+	}
+	if c.NextSQLPort != 0 && c.NextSQLPort == t.SQLPort+1 {
+		c.NextSQLPort = t.SQLPort
+	}
+	if t.freshDir {
+		if err := os.RemoveAll(t.Dir); err != nil {
+			log.Printf("node %s: unable to remove %s: %s", t.Name, t.Dir, err)
+		}
+	}
+}
+
+// boot creates the initial count nodes, starting them in batches of
+// concurrency nodes separated by stagger. This gives the bootstrap node time
+// to come up before the others try to join it. It stops at the first node
+// which can't be created. The nodes are recorded as added.
+func (c *cluster) boot(count, concurrency int, stagger time.Duration) error {
+	c.recorder.record("add", "", count)
+	return c.bootNodes(count, concurrency, stagger)
+}
+
+// bootNodes boots count nodes as boot does, without recording them.
+func (c *cluster) bootNodes(count, concurrency int, stagger time.Duration) error {
+	if concurrency <= 0 {
+		concurrency = count
+	}
+	for i := 0; i < count; i++ {
+		if i > 0 && i%concurrency == 0 && stagger > 0 {
+			time.Sleep(stagger)
+		}
+		if _, err := c.newNode(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func redirect(rw http.ResponseWriter, req *http.Request) {
@@ -115,13 +538,131 @@ func (c *cluster) showCluster(rw http.ResponseWriter, req *http.Request, args ma
 		"Title":   "cluster",
 		"Page":    "Nodes",
 		"Cluster": c,
-		"Nodes":   c.Nodes,
+		"Nodes":   c.sortedNodes(),
+		"Tenants": c.sortedTenants(),
+	}
+	columns := visibleColumns(req)
+	data["Columns"] = columns
+	data["ColumnSpan"] = len(columns)
+	data["DashboardColumns"] = dashboardColumns
+	renderLayout(rw, req, "cluster.html", "layout.html", "Content", data)
+}
+
+type apiNode struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Healthy reports whether the latest readiness probe of the running node
+	// succeeded.
+	Healthy bool   `json:"healthy"`
+	URL     string `json:"url"`
+	// Build is the build of the binary of the node's latest run, if known.
+	Build *cockroachBuild `json:"build,omitempty"`
+}
+
+func (c *cluster) apiNodes(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		c.apiAddNode(rw, req)
+		return
+	default:
+		http.Error(rw, "use GET or POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	nodes := []apiNode{}
+	for _, t := range c.sortedNodes() {
+		nodes = append(nodes, makeAPINode(t))
+	}
+	rw.Header().Set(nameHeader, *demoName)
+	writeJSON(rw, nodes)
+}
+
+// isMemStore returns whether the store spec describes an in-memory store.
+func isMemStore(spec string) bool {
+	for _, field := range strings.Split(spec, ",") {
+		if field == "type=mem" {
+			return true
+		}
 	}
-	renderLayout(rw, "cluster.html", "layout.html", "Content", data)
+	return false
+}
+
+// bootstrapNode is the name of the node listening on basePort, which every
+// node joins through.
+const bootstrapNode = "1"
+
+// BootstrapDown returns true if the bootstrap node exists but is not running,
+// in which case newly added nodes are unable to join the cluster.
+func (c *cluster) BootstrapDown() bool {
+	t, ok := c.Nodes[bootstrapNode]
+	return ok && t.Status() != "Running"
 }
 
+// maxAddCount is the maximum number of nodes which can be added at once.
+const maxAddCount = 32
+
 func (c *cluster) addNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
-	c.newNode()
+	if c.BootstrapDown() {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, fmt.Sprintf("node %s, which new nodes join through, is %s; start it before adding nodes",
+			bootstrapNode, c.Nodes[bootstrapNode].Status()))
+		return
+	}
+	count := 1
+	if v := req.FormValue("count"); v != "" {
+		var err error
+		count, err = strconv.Atoi(v)
+		if err != nil || count < 1 || count > maxAddCount {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, fmt.Sprintf("invalid count %q: must be between 1 and %d", v, maxAddCount))
+			return
+		}
+	}
+	if err := c.portsAvailable(count); err != nil {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, err.Error())
+		return
+	}
+	if store := req.FormValue("store"); store != "" {
+		next := c.nextNodeID()
+		for i := 0; i < count; i++ {
+			c.stores[next+i] = store
+		}
+	}
+	if seed := req.FormValue("seed"); seed != "" {
+		if count != 1 {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, "only a single node can be seeded from a store")
+			return
+		}
+		// The node is left stopped if seeding fails so that it can be
+		// retried from the node's page. A replay adds it unseeded.
+		c.recorder.record("add", "", 1)
+		t, err := c.createNode()
+		if err != nil {
+			rw.WriteHeader(http.StatusInternalServerError)
+			renderError(rw, err.Error())
+			return
+		}
+		if err := t.seedStore(seed); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, fmt.Sprintf("created node %s stopped, unable to seed it: %s", t.Name, err))
+			return
+		}
+		c.events.add(t.Name, "seeded store from %s", seed)
+		t.startService()
+		redirect(rw, req)
+		return
+	}
+	// All of the nodes are booted before responding, staggers included:
+	// creating nodes in the background would change c.Nodes while other
+	// requests read it.
+	if err := c.boot(count, *bootConcurrency, *bootStagger); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
 	redirect(rw, req)
 }
 
@@ -157,8 +698,8 @@ func (c *cluster) startNode(rw http.ResponseWriter, req *http.Request, args map[
 		return
 	}
 
-	t.Service = true
-	t.start()
+	c.recorder.record("start", t.Name, 0)
+	t.startService()
 
 	redirect(rw, req)
 }
@@ -169,8 +710,303 @@ func (c *cluster) stopNode(rw http.ResponseWriter, req *http.Request, args map[s
 		return
 	}
 
-	t.Service = false
-	t.stop()
+	c.recorder.record("stop", t.Name, 0)
+	t.stopService()
+
+	redirect(rw, req)
+}
+
+func (c *cluster) rerunNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	run := c.findNodeRun(rw, t, args)
+	if run == nil {
+		return
+	}
+
+	if t.Active() != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("node %s must be stopped before re-running run %d", t.Name, run.ID))
+		return
+	}
+	if err := t.checkPinnedBinary(run.Args[0]); err != nil {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, err.Error())
+		return
+	}
+
+	t.startMu.Lock()
+	t.Service = true
+	t.startMu.Unlock()
+	t.rerun(run)
+
+	redirect(rw, req)
+}
+
+// upgradeNode switches the node to the binary specified by the bin parameter
+// and gracefully restarts it if it is running. As it executes the binary, it
+// is routed through requireConfirmation.
+func (c *cluster) upgradeNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	if t.Container != "" {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("node %s runs in docker and cannot be upgraded to a local binary", t.Name))
+		return
+	}
+
+	bin := req.FormValue("bin")
+	path, err := exec.LookPath(bin)
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("invalid binary %q: %s", bin, err))
+		return
+	}
+	if t.PinnedBinary != "" && !sameBinary(path, t.PinnedBinary) {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, fmt.Sprintf("node %s is pinned to %s (%s); unpin it before upgrading",
+			t.Name, t.PinnedBinary, t.PinnedVersion))
+		return
+	}
+	newVersion, err := cockroachVersion(path)
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("unable to determine version of %s: %s", path, err))
+		return
+	}
+	oldVersion, err := cockroachVersion(t.Binary())
+	if err != nil {
+		oldVersion = "unknown version"
+	}
+
+	c.events.add(t.Name, "upgrading from %s (%s) to %s (%s)", t.Binary(), oldVersion, path, newVersion)
+	t.startMu.Lock()
+	t.Args[0] = path
+	t.startMu.Unlock()
+	if t.Active() != nil {
+		t.gracefulRestart()
+	}
+
+	redirect(rw, req)
+}
+
+var localityRE = regexp.MustCompile(`^[^=,\s]+=[^=,\s]+(,[^=,\s]+=[^=,\s]+)*$`)
+var attrsRE = regexp.MustCompile(`^[^:,=\s]+(:[^:,=\s]+)*$`)
+
+// setNodePlacement sets the node's attrs and/or locality, from the form values
+// of the same names, and gracefully restarts the node so that cockroach picks
+// them up. An empty value clears the setting.
+func (c *cluster) setNodePlacement(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	if err := req.ParseForm(); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, err.Error())
+		return
+	}
+	attributes, setAttrs := req.Form["attrs"]
+	locality, setLocality := req.Form["locality"]
+	if setAttrs && attributes[0] != "" && !attrsRE.MatchString(attributes[0]) {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("invalid attrs %q: expected e.g. ssd:x16c", attributes[0]))
+		return
+	}
+	if setLocality && locality[0] != "" && !localityRE.MatchString(locality[0]) {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("invalid locality %q: expected e.g. region=us-west1,zone=a", locality[0]))
+		return
+	}
+
+	changed := false
+	if setAttrs && attributes[0] != t.Attrs {
+		c.events.add(t.Name, "attrs changed from %q to %q", t.Attrs, attributes[0])
+		t.Attrs = attributes[0]
+		t.setFlag("attrs", t.Attrs)
+		changed = true
+	}
+	if setLocality && locality[0] != t.Locality {
+		c.events.add(t.Name, "locality changed from %q to %q", t.Locality, locality[0])
+		t.Locality = locality[0]
+		t.setFlag("locality", t.Locality)
+		changed = true
+	}
+	if changed && t.Active() != nil {
+		t.gracefulRestart()
+	}
+
+	redirect(rw, req)
+}
+
+func (c *cluster) reapNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	if t.reap() {
+		c.events.add(t.Name, "reaped dead run")
+	}
+
+	redirect(rw, req)
+}
+
+// reapInterval is how often the reaper verifies that running nodes are
+// alive.
+const reapInterval = 10 * time.Second
+
+// reaper periodically reaps runs whose processes have died without the wait
+// goroutine noticing, and prunes the buffers of runs older than
+// -buffer-retention.
+func (c *cluster) reaper() {
+	for range time.Tick(reapInterval) {
+		for _, t := range c.sortedNodes() {
+			if t.reap() {
+				c.events.add(t.Name, "reaped dead run")
+			}
+			if *bufferRetention > 0 {
+				t.pruneBuffers(*bufferRetention)
+			}
+		}
+	}
+}
+
+func (c *cluster) pinNodeRun(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	run := c.findNodeRun(rw, t, args)
+	if run == nil {
+		return
+	}
+
+	t.pin(run)
+
+	redirect(rw, req)
+}
+
+// resetNodeBackoff clears the node's restart backoff state. A node disabled by
+// the failure cap is left stopped but may be started again.
+func (c *cluster) resetNodeBackoff(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	t.resetBackoff()
+
+	redirect(rw, req)
+}
+
+// slowStartNode delays the start of the node's next run by the duration
+// specified by the delay parameter.
+func (c *cluster) slowStartNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	delay, err := time.ParseDuration(req.FormValue("delay"))
+	if err != nil || delay < 0 {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("invalid delay: %q", req.FormValue("delay")))
+		return
+	}
+	t.SlowStart = delay
+
+	redirect(rw, req)
+}
+
+func (c *cluster) noteNodeRun(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	run := c.findNodeRun(rw, t, args)
+	if run == nil {
+		return
+	}
+
+	if req.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		renderError(rw, "notes must be updated with POST")
+		return
+	}
+	if err := t.setNotes(run.ID, req.FormValue("notes")); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
+
+	redirect(rw, req)
+}
+
+// signalNode sends the signal named by the "sig" form value to the node's
+// active run.
+func (c *cluster) signalNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	r := t.Active()
+	if r == nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("node %s is not running", t.Name))
+		return
+	}
+	sig := req.FormValue("sig")
+	if _, ok := signals[sig]; !ok {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("unsupported signal %q", sig))
+		return
+	}
+	if err := r.signal(sig); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
+	c.events.add(t.Name, "sent %s to run %d", sig, r.ID)
+
+	redirect(rw, req)
+}
+
+// reopenLogs sends SIGHUP to the node's active run, causing cockroach to
+// reopen its log files after they have been rotated.
+func (c *cluster) reopenLogs(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	r := t.Active()
+	if r == nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("node %s is not running", t.Name))
+		return
+	}
+	if !t.LogsToFiles() {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("node %s does not log to files", t.Name))
+		return
+	}
+	if err := r.signal("SIGHUP"); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
+	c.events.add(t.Name, "reopened logs of run %d", r.ID)
 
 	redirect(rw, req)
 }
@@ -181,6 +1017,7 @@ func (c *cluster) pauseNode(rw http.ResponseWriter, req *http.Request, args map[
 		return
 	}
 
+	c.recorder.record("pause", t.Name, 0)
 	t.pause()
 
 	redirect(rw, req)
@@ -192,36 +1029,149 @@ func (c *cluster) resumeNode(rw http.ResponseWriter, req *http.Request, args map
 		return
 	}
 
+	c.recorder.record("resume", t.Name, 0)
 	t.resume()
 
 	redirect(rw, req)
 }
 
-func (c *cluster) startAll(rw http.ResponseWriter, req *http.Request, args map[string]string) {
-	for _, t := range c.Nodes {
-		t.start()
+// nodeAction starts, stops, pauses, resumes, restarts or removes the node,
+// recording the action.
+func (c *cluster) nodeAction(t *node, action string) error {
+	if err := c.applyNodeAction(t, action); err != nil {
+		return err
+	}
+	c.recorder.record(action, t.Name, 0)
+	return nil
+}
+
+// applyNodeAction applies the action to the node as nodeAction does, without
+// recording it.
+func (c *cluster) applyNodeAction(t *node, action string) error {
+	switch action {
+	case "start":
+		t.startService()
+	case "stop":
+		t.stopService()
+	case "pause":
+		t.pause()
+	case "resume":
+		t.resume()
+	case "restart":
+		if t.Active() == nil {
+			return fmt.Errorf("node %s is not running", t.Name)
+		}
+		t.gracefulRestart()
+	case "remove":
+		return c.removeNode(t)
+	default:
+		return fmt.Errorf("unknown action %q", action)
 	}
+	return nil
+}
+
+// applyAllNodes applies the action, one of startall, stopall, pauseall and
+// resumeall, to all of the nodes without recording it.
+func (c *cluster) applyAllNodes(action string) {
+	for _, t := range c.sortedNodes() {
+		switch action {
+		case "startall":
+			t.startService()
+		case "stopall":
+			t.stopService()
+		case "pauseall":
+			t.pause()
+		case "resumeall":
+			t.resume()
+		}
+	}
+}
+
+// startAllNodes starts all of the nodes as services.
+func (c *cluster) startAllNodes() {
+	c.recorder.record("startall", "", 0)
+	c.applyAllNodes("startall")
+}
+
+// stopAllNodes stops all of the nodes, which are then not restarted.
+func (c *cluster) stopAllNodes() {
+	c.recorder.record("stopall", "", 0)
+	c.applyAllNodes("stopall")
+}
+
+func (c *cluster) startAll(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	c.startAllNodes()
 	redirect(rw, req)
 }
 
 func (c *cluster) stopAll(rw http.ResponseWriter, req *http.Request, args map[string]string) {
-	for _, t := range c.Nodes {
-		t.stop()
+	c.stopAllNodes()
+	redirect(rw, req)
+}
+
+// flushAll syncs the log files of all active runs to disk. The stores of
+// running nodes are written by cockroach itself and there is no way to force
+// it to fsync them from the outside; the stores of stopped nodes were synced
+// by cockroach when it exited.
+func (c *cluster) flushAll(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	for _, t := range append(c.sortedNodes(), c.sortedTenants()...) {
+		r := t.Active()
+		if r == nil {
+			continue
+		}
+		if err := r.syncOutput(); err != nil {
+			rw.WriteHeader(http.StatusInternalServerError)
+			renderError(rw, fmt.Sprintf("unable to sync logs of %s: %s", t.Name, err))
+			return
+		}
 	}
 	redirect(rw, req)
 }
 
+// pauseAllNodes pauses all of the nodes.
+func (c *cluster) pauseAllNodes() {
+	c.recorder.record("pauseall", "", 0)
+	c.applyAllNodes("pauseall")
+}
+
+// resumeAllNodes resumes all of the paused nodes.
+func (c *cluster) resumeAllNodes() {
+	c.recorder.record("resumeall", "", 0)
+	c.applyAllNodes("resumeall")
+}
+
 func (c *cluster) pauseAll(rw http.ResponseWriter, req *http.Request, args map[string]string) {
-	for _, t := range c.Nodes {
-		t.pause()
-	}
+	c.pauseAllNodes()
 	redirect(rw, req)
 }
 
 func (c *cluster) resumeAll(rw http.ResponseWriter, req *http.Request, args map[string]string) {
-	for _, t := range c.Nodes {
-		t.resume()
+	c.resumeAllNodes()
+	redirect(rw, req)
+}
+
+var vmoduleRE = regexp.MustCompile(`^[^=,\s]+=\d+(,[^=,\s]+=\d+)*$`)
+
+// setLogLevel sets the --vmodule flag for all current and future nodes. The
+// setting takes effect when a node is next started; passing restart=true
+// restarts running nodes immediately.
+func (c *cluster) setLogLevel(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	vmodule := req.FormValue("vmodule")
+	if vmodule != "" && !vmoduleRE.MatchString(vmodule) {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("invalid vmodule: %s", vmodule))
+		return
 	}
+
+	c.Vmodule = vmodule
+	restart := req.FormValue("restart") == "true"
+	for _, t := range c.sortedNodes() {
+		t.setFlag("vmodule", vmodule)
+		if restart && t.Active() != nil {
+			t.restart()
+		}
+	}
+
 	redirect(rw, req)
 }
 
@@ -237,8 +1187,60 @@ func (c *cluster) nodeHistory(rw http.ResponseWriter, req *http.Request, args ma
 		"Cluster": c,
 		"Node":    t,
 	}
+	data["AllowTracing"] = *allowTracing
+	data["AllowFaults"] = *allowFaults
 
-	renderLayout(rw, "node.html", "layout.html", "Content", data)
+	renderLayout(rw, req, "node.html", "layout.html", "Content", data)
+}
+
+func (c *cluster) nodeHistoryCSV(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/csv")
+	rw.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"node-%s-history.csv\"", t.Name))
+
+	w := csv.NewWriter(rw)
+	_ = w.Write([]string{
+		"id", "started", "stopped", "duration", "exit status", "signal", "stdout size", "stderr size",
+		"build tag", "build commit",
+	})
+	for _, r := range t.Runs {
+		var started, stopped, duration, exitStatus, signal, tag, commit string
+		if !r.Started.IsZero() {
+			started = r.Started.Format(time.RFC3339)
+		}
+		if !r.Stopped.IsZero() {
+			stopped = r.Stopped.Format(time.RFC3339)
+			duration = r.Stopped.Sub(r.Started).String()
+			exitStatus = strconv.Itoa(r.WaitStatus.ExitStatus())
+			if r.WaitStatus.Signaled() {
+				signal = r.WaitStatus.Signal().String()
+			}
+		}
+		if r.Build != nil {
+			tag, commit = r.Build.Tag, r.Build.Commit
+		}
+		_ = w.Write([]string{
+			strconv.Itoa(r.ID),
+			started,
+			stopped,
+			duration,
+			exitStatus,
+			signal,
+			strconv.FormatInt(r.StdoutLen(), 10),
+			strconv.FormatInt(r.StderrLen(), 10),
+			tag,
+			commit,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.Print(err)
+	}
 }
 
 func (c *cluster) nodeRunPage(rw http.ResponseWriter, req *http.Request, args map[string]string) {
@@ -260,7 +1262,27 @@ func (c *cluster) nodeRunPage(rw http.ResponseWriter, req *http.Request, args ma
 		"NodeRun": run,
 	}
 
-	renderLayout(rw, "run.html", "layout.html", "Content", data)
+	renderLayout(rw, req, "run.html", "layout.html", "Content", data)
+}
+
+// notModified sets Last-Modified to the modification time of the log and
+// returns true, after responding with 304 Not Modified, if the log has not
+// been modified since the request's If-Modified-Since time.
+func notModified(rw http.ResponseWriter, req *http.Request, w logWriter) bool {
+	if w == nil {
+		return false
+	}
+	mod := w.ModTime()
+	if mod.IsZero() {
+		return false
+	}
+	rw.Header().Set("Last-Modified", mod.UTC().Format(http.TimeFormat))
+	since, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil || mod.Truncate(time.Second).After(since) {
+		return false
+	}
+	rw.WriteHeader(http.StatusNotModified)
+	return true
 }
 
 func (c *cluster) nodeRunStdout(rw http.ResponseWriter, req *http.Request, args map[string]string) {
@@ -274,6 +1296,15 @@ func (c *cluster) nodeRunStdout(rw http.ResponseWriter, req *http.Request, args
 		return
 	}
 
+	if notModified(rw, req, run.stdoutLog()) {
+		return
+	}
+
+	output, seek, ok := runLogOutput(rw, req, run, "stdout")
+	if !ok {
+		return
+	}
+
 	data := map[string]interface{}{
 		"Title":     "Node run stdout",
 		"Page":      "NodeOutput",
@@ -281,10 +1312,12 @@ func (c *cluster) nodeRunStdout(rw http.ResponseWriter, req *http.Request, args
 		"Cluster":   c,
 		"Node":      t,
 		"NodeRun":   run,
-		"LogOutput": run.StdoutBuf.String(),
+		"LogOutput": logOutput(req, output),
+		"Color":     req.FormValue("color") == "true",
 	}
+	data["Seek"] = seek
 
-	renderLayout(rw, "log.html", "layout.html", "Content", data)
+	renderLayout(rw, req, "log.html", "layout.html", "Content", data)
 }
 
 func (c *cluster) nodeRunStderr(rw http.ResponseWriter, req *http.Request, args map[string]string) {
@@ -298,6 +1331,21 @@ func (c *cluster) nodeRunStderr(rw http.ResponseWriter, req *http.Request, args
 		return
 	}
 
+	if run.Merged {
+		rw.Header().Set("Location", fmt.Sprintf("%s/node/%s/run/%d/stdout", *basePath, t.Name, run.ID))
+		rw.WriteHeader(http.StatusFound)
+		return
+	}
+
+	if notModified(rw, req, run.stderrLog()) {
+		return
+	}
+
+	output, seek, ok := runLogOutput(rw, req, run, "stderr")
+	if !ok {
+		return
+	}
+
 	data := map[string]interface{}{
 		"Title":     "Node run stderr",
 		"Page":      "NodeOutput",
@@ -305,15 +1353,132 @@ func (c *cluster) nodeRunStderr(rw http.ResponseWriter, req *http.Request, args
 		"Cluster":   c,
 		"Node":      t,
 		"NodeRun":   run,
-		"LogOutput": run.StderrBuf.String(),
+		"LogOutput": logOutput(req, output),
+		"Color":     req.FormValue("color") == "true",
 	}
+	data["Seek"] = seek
+
+	renderLayout(rw, req, "log.html", "layout.html", "Content", data)
+}
 
-	renderLayout(rw, "log.html", "layout.html", "Content", data)
+type clusterSummary struct {
+	Nodes     int
+	Running   int
+	Stopped   int
+	Paused    int
+	Restarts  int
+	DiskUsage string
+	Uptime    time.Duration
+
+	// VCPUs is the total number of vCPUs of the nodes, counting nodes
+	// without a limit as having all of the host's CPUs, and CPULimited
+	// indicates that some node has a limit.
+	VCPUs      int
+	CPULimited bool
+}
+
+// Summary returns cluster-wide statistics for the dashboard. Uptime is
+// measured from the start of the earliest still running node.
+func (c *cluster) Summary() clusterSummary {
+	var s clusterSummary
+	var diskUsage int64
+	var earliest time.Time
+	for _, t := range c.Nodes {
+		s.Nodes++
+		switch t.Status() {
+		case "Running":
+			s.Running++
+		case "Paused", "Zombie":
+			s.Paused++
+		default:
+			s.Stopped++
+		}
+		s.Restarts += t.Restarts()
+		diskUsage += t.DiskUsage()
+		s.VCPUs += t.VCPUs()
+		if t.CPUs > 0 {
+			s.CPULimited = true
+		}
+		if r := t.Active(); r != nil && (earliest.IsZero() || r.Started.Before(earliest)) {
+			earliest = r.Started
+		}
+	}
+	s.DiskUsage = humanizeBytes(diskUsage)
+	if !earliest.IsZero() {
+		s.Uptime = time.Since(earliest).Round(time.Second)
+	}
+	return s
+}
+
+type quorumStatus struct {
+	// Quorum is true if every range is expected to have a majority of its
+	// replicas on running nodes, assuming the worst case placement of
+	// replicas on the nodes which are down.
+	Quorum bool `json:"quorum"`
+	// AtRisk is true if losing one more node may cost a range its quorum.
+	AtRisk            bool `json:"at_risk"`
+	Running           int  `json:"running"`
+	Total             int  `json:"total"`
+	ReplicationFactor int  `json:"replication_factor"`
+	// Tolerated is the number of nodes that can be down without losing
+	// quorum.
+	Tolerated int `json:"tolerated"`
+}
+
+// Quorum estimates whether the cluster has quorum based on the number of
+// running nodes and the replication factor.
+func (c *cluster) Quorum() quorumStatus {
+	q := quorumStatus{
+		Total:             len(c.Nodes),
+		ReplicationFactor: *replicationFactor,
+	}
+	for _, t := range c.Nodes {
+		if t.Status() == "Running" {
+			q.Running++
+		}
+	}
+	rf := q.ReplicationFactor
+	if rf > q.Total {
+		rf = q.Total
+	}
+	q.Tolerated = rf - (rf/2 + 1)
+	if q.Tolerated < 0 {
+		q.Tolerated = 0
+	}
+	down := q.Total - q.Running
+	q.Quorum = q.Total > 0 && down <= q.Tolerated
+	q.AtRisk = q.Quorum && down == q.Tolerated
+	return q
+}
+
+// apiQuorumStatus is the quorum status returned by /api/quorum.
+type apiQuorumStatus struct {
+	// Name is the -name of this roachdemo.
+	Name string `json:"name"`
+	quorumStatus
+}
+
+func (c *cluster) apiQuorum(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	rw.Header().Set(nameHeader, *demoName)
+	writeJSON(rw, apiQuorumStatus{Name: *demoName, quorumStatus: c.Quorum()})
+}
+
+func humanizeBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
 }
 
 func (c *cluster) AnyNodesStarted() bool {
 	for _, t := range c.Nodes {
-		if t.Active != nil {
+		if t.Active() != nil {
 			return true
 		}
 	}
@@ -322,7 +1487,7 @@ func (c *cluster) AnyNodesStarted() bool {
 
 func (c *cluster) AnyNodesStopped() bool {
 	for _, t := range c.Nodes {
-		if t.Active == nil {
+		if t.Active() == nil {
 			return true
 		}
 	}
@@ -331,8 +1496,8 @@ func (c *cluster) AnyNodesStopped() bool {
 
 func (c *cluster) AnyNodesPaused() bool {
 	for _, t := range c.Nodes {
-		if t.Active != nil {
-			if t.Active.Paused {
+		if r := t.Active(); r != nil {
+			if r.Paused {
 				return true
 			}
 		}
@@ -342,8 +1507,8 @@ func (c *cluster) AnyNodesPaused() bool {
 
 func (c *cluster) AnyNodesNotPaused() bool {
 	for _, t := range c.Nodes {
-		if t.Active != nil {
-			if !t.Active.Paused {
+		if r := t.Active(); r != nil {
+			if !r.Paused {
 				return true
 			}
 		}
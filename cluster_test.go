@@ -0,0 +1,280 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEmptyCluster(t *testing.T) {
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+
+	rw := httptest.NewRecorder()
+	c.showCluster(rw, httptest.NewRequest("GET", "/", nil), nil)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected the dashboard to render, got %d: %s", rw.Code, rw.Body)
+	}
+	if !strings.Contains(rw.Body.String(), "Add your first node") {
+		t.Fatalf("expected the add-first-node call to action")
+	}
+
+	if c.AnyNodesStarted() || c.AnyNodesStopped() || c.AnyNodesPaused() || c.AnyNodesNotPaused() {
+		t.Fatalf("expected no nodes to be started, stopped, paused or not paused")
+	}
+
+	for _, tc := range []struct {
+		path string
+		fn   routeFn
+	}{
+		{"/startall", c.startAll},
+		{"/stopall", c.stopAll},
+		{"/pauseall", c.pauseAll},
+		{"/resumeall", c.resumeAll},
+	} {
+		rw := httptest.NewRecorder()
+		tc.fn(rw, httptest.NewRequest("POST", tc.path, nil), nil)
+		if rw.Code != http.StatusFound && rw.Code != http.StatusSeeOther {
+			t.Errorf("%s: expected a redirect, got %d: %s", tc.path, rw.Code, rw.Body)
+		}
+		if len(c.Nodes) != 0 {
+			t.Fatalf("%s: expected no nodes, got %d", tc.path, len(c.Nodes))
+		}
+	}
+}
+
+func TestConcurrentStart(t *testing.T) {
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	n := newNode("1", []string{"/bin/sh", "-c", "sleep 30"}, nil, false, "", "", "", "")
+	c.Nodes[n.Name] = n
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rw := httptest.NewRecorder()
+			c.startNode(rw, httptest.NewRequest("POST", "/node/1/start", nil), map[string]string{"node": "1"})
+		}()
+	}
+	wg.Wait()
+
+	if len(n.Runs) != 1 {
+		t.Fatalf("expected exactly 1 run, got %d", len(n.Runs))
+	}
+	if n.Status() != "Running" {
+		t.Fatalf("expected the node to be running, got %s", n.Status())
+	}
+	r := n.Active()
+	n.stopService()
+	waitRun(t, r)
+	if n.Active() != nil || len(n.Runs) != 1 {
+		t.Fatalf("expected the stopped node not to be restarted")
+	}
+}
+
+func TestNodeIDsNotReused(t *testing.T) {
+	inTempDir(t)
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	for _, name := range []string{"1", "2"} {
+		n, err := c.createNode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n.Name != name {
+			t.Fatalf("expected node %s, got %s", name, n.Name)
+		}
+	}
+	if err := c.removeNode(c.Nodes["2"]); err != nil {
+		t.Fatal(err)
+	}
+	n, err := c.createNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Name != "3" {
+		t.Fatalf("expected the removed node's ID not to be reused, got node %s", n.Name)
+	}
+}
+
+func TestSortedNodes(t *testing.T) {
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	for _, name := range []string{"10", "2", "1", "9", "11"} {
+		c.Nodes[name] = newNode(name, []string{"/bin/true"}, nil, false, "", "", "", "")
+	}
+	want := []string{"1", "2", "9", "10", "11"}
+
+	var names []string
+	for _, n := range c.sortedNodes() {
+		names = append(names, n.Name)
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Fatalf("expected %v, got %v", want, names)
+	}
+
+	rw := httptest.NewRecorder()
+	c.apiNodes(rw, httptest.NewRequest("GET", "/api/nodes", nil), nil)
+	var nodes []apiNode
+	if err := json.Unmarshal(rw.Body.Bytes(), &nodes); err != nil {
+		t.Fatal(err)
+	}
+	names = nil
+	for _, n := range nodes {
+		names = append(names, n.Name)
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Fatalf("expected /api/nodes to list %v, got %v", want, names)
+	}
+}
+
+func TestMaxPortExhausted(t *testing.T) {
+	inTempDir(t)
+	defer func(p int) { *maxPort = p }(*maxPort)
+	*maxPort = basePort + 3
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	for i := 0; i < 2; i++ {
+		if err := c.portsAvailable(1); err != nil {
+			t.Fatalf("node %d: %s", i+1, err)
+		}
+		if _, err := c.createNode(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Nodes are only added while the bootstrap node is running.
+	// The shell execs sleep so that killing the run closes its output.
+	n := c.Nodes[bootstrapNode]
+	n.Args = []string{"/bin/sh", "-c", "exec sleep 30"}
+	n.startService()
+	defer func() {
+		r := n.Active()
+		n.stopService()
+		waitRun(t, r)
+	}()
+	if left := c.NodesLeft(); left != 0 {
+		t.Fatalf("expected no room for more nodes, got %d", left)
+	}
+
+	rw := httptest.NewRecorder()
+	c.apiNodes(rw, httptest.NewRequest("POST", "/api/nodes", nil), nil)
+	if rw.Code != http.StatusConflict || !strings.Contains(rw.Body.String(), "-max-port") {
+		t.Fatalf("expected a -max-port conflict, got %d: %s", rw.Code, rw.Body)
+	}
+	rw = httptest.NewRecorder()
+	c.addNode(rw, httptest.NewRequest("POST", "/add", nil), nil)
+	if rw.Code != http.StatusConflict || !strings.Contains(rw.Body.String(), "-max-port") {
+		t.Fatalf("expected a -max-port conflict, got %d: %s", rw.Code, rw.Body)
+	}
+	if len(c.Nodes) != 2 || c.NextPort != basePort+4 {
+		t.Fatalf("expected the refused adds to leave the cluster alone, got %d nodes and next port %d",
+			len(c.Nodes), c.NextPort)
+	}
+}
+
+func TestSQLPortsAvailable(t *testing.T) {
+	defer func(p int) { *sqlBasePort = p }(*sqlBasePort)
+	*sqlBasePort = basePort - 2
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	if err := c.portsAvailable(2); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.portsAvailable(3); err == nil || !strings.Contains(err.Error(), "-sql-port") {
+		t.Fatalf("expected SQL ports reaching the node port range to be refused, got %v", err)
+	}
+
+	*sqlBasePort = 0
+	c = newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	if err := c.portsAvailable(maxAddCount); err != nil {
+		t.Fatalf("expected no limit without -sql-port or -max-port: %s", err)
+	}
+}
+
+func TestNodeFlagsManaged(t *testing.T) {
+	inTempDir(t)
+	defer func(p int) { *sqlBasePort = p }(*sqlBasePort)
+	*sqlBasePort = basePort - 100
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	n, err := c.createNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sqlAddr bool
+	for _, arg := range n.Args[2:] {
+		if arg == "--insecure" || strings.HasPrefix(arg, "--cache=") {
+			continue
+		}
+		sqlAddr = sqlAddr || strings.HasPrefix(arg, "--sql-addr=")
+		if v := validateArgs(nil, []string{arg}, nil); len(v.Warnings) != 1 {
+			t.Errorf("expected overriding %s to be warned about, got %v", arg, v.Warnings)
+		}
+	}
+	if !sqlAddr {
+		t.Fatalf("expected the node to have a SQL address: %v", n.Args)
+	}
+}
+
+func TestFailedStartDiscarded(t *testing.T) {
+	inTempDir(t)
+	defer func(bin string) { cockroachBin = bin }(cockroachBin)
+	cockroachBin = "/nonexistent/cockroach"
+	stores := perNodeAttribute{1: "type=mem,size=1GiB"}
+	c := newCluster(nil, nil, nil, stores, nil, "localhost", "")
+
+	if _, err := c.newNode(); err == nil {
+		t.Fatalf("expected starting the node to fail")
+	}
+	if len(c.Nodes) != 0 {
+		t.Fatalf("expected the node to be discarded, got %d nodes", len(c.Nodes))
+	}
+	if _, ok := c.stores[1]; ok {
+		t.Fatalf("expected the node's store spec to be discarded")
+	}
+
+	// The next node takes the discarded node's ID and ports.
+	n, err := c.createNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Name != "1" || n.Port != basePort || n.Store == "type=mem,size=1GiB" {
+		t.Fatalf("expected node 1 on port %d with the default store, got node %s on port %d with store %s",
+			basePort, n.Name, n.Port, n.Store)
+	}
+}
+
+func TestAddNodesStaggered(t *testing.T) {
+	inTempDir(t)
+	defer func(bin string) { cockroachBin = bin }(cockroachBin)
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The nodes keep running until they are stopped, so that they aren't
+	// restarted after the test.
+	cockroachBin = filepath.Join(dir, "cockroach")
+	if err := ioutil.WriteFile(cockroachBin, []byte("#!/bin/sh\n[ \"$1\" = version ] && exit 0\nexec sleep 30\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer func(n int, d time.Duration) { *bootConcurrency, *bootStagger = n, d }(*bootConcurrency, *bootStagger)
+	*bootConcurrency, *bootStagger = 1, 10*time.Millisecond
+
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	rw := httptest.NewRecorder()
+	c.addNode(rw, httptest.NewRequest("POST", "/add?count=3", nil), nil)
+	for _, n := range c.Nodes {
+		r := n.Active()
+		n.stopService()
+		waitRun(t, r)
+	}
+	if rw.Code != http.StatusFound {
+		t.Fatalf("expected a redirect, got %d: %s", rw.Code, rw.Body)
+	}
+	if len(c.Nodes) != 3 {
+		t.Fatalf("expected all of the nodes to be added before responding, got %d", len(c.Nodes))
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// apiClusterState is the state of the cluster returned by /api/cluster.
+// It only holds fields which change when the cluster does, so that its ETag
+// is stable while the cluster is idle.
+type apiClusterState struct {
+	// ETag identifies the state, to be passed as since to the next request.
+	ETag string `json:"etag"`
+	// Name is the -name of this roachdemo.
+	Name   string       `json:"name"`
+	Nodes  []apiNode    `json:"nodes"`
+	Quorum quorumStatus `json:"quorum"`
+	// EventID is the ID of the most recent event (see /api/events).
+	EventID int `json:"event_id"`
+}
+
+// clusterState returns the state of the cluster, with its ETag computed as
+// a hash of the rest of the state serialized.
+func (c *cluster) clusterState() (apiClusterState, error) {
+	s := apiClusterState{
+		Name:    *demoName,
+		Nodes:   []apiNode{},
+		Quorum:  c.Quorum(),
+		EventID: c.events.latestID(),
+	}
+	for _, t := range c.sortedNodes() {
+		s.Nodes = append(s.Nodes, makeAPINode(t))
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return s, err
+	}
+	sum := sha256.Sum256(b)
+	s.ETag = hex.EncodeToString(sum[:8])
+	return s, nil
+}
+
+// apiCluster returns the state of the cluster, or 304 Not Modified if its
+// ETag matches the since parameter or the If-None-Match header, so that
+// clients polling the cluster only fetch and process changes.
+func (c *cluster) apiCluster(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	s, err := c.clusterState()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("ETag", `"`+s.ETag+`"`)
+	rw.Header().Set(nameHeader, *demoName)
+	since := req.FormValue("since")
+	if since == "" {
+		since = strings.Trim(strings.TrimPrefix(req.Header.Get("If-None-Match"), "W/"), `"`)
+	}
+	if since == s.ETag {
+		rw.WriteHeader(http.StatusNotModified)
+		return
+	}
+	writeJSON(rw, s)
+}
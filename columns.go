@@ -0,0 +1,56 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+)
+
+// columnsCookie is the cookie holding the comma-separated keys of the
+// columns of the dashboard's node table which are shown. The Node column is
+// always shown.
+const columnsCookie = "columns"
+
+// dashboardColumn is an optional column of the dashboard's node table.
+type dashboardColumn struct {
+	Key   string
+	Title string
+	// Default is set for the columns shown if the client hasn't chosen.
+	Default bool
+}
+
+// dashboardColumns are the optional columns of the node table, in the order
+// they are shown.
+var dashboardColumns = []dashboardColumn{
+	{Key: "url", Title: "URL", Default: true},
+	{Key: "version", Title: "Version"},
+	{Key: "uptime", Title: "Uptime"},
+	{Key: "restarts", Title: "Restarts"},
+	{Key: "disk", Title: "Disk"},
+	{Key: "usage", Title: "Usage", Default: true},
+	{Key: "logs", Title: "Logs", Default: true},
+	{Key: "actions", Title: "Actions", Default: true},
+}
+
+// visibleColumns returns the set of the keys of the dashboard columns chosen
+// by the client, or of the default columns if it hasn't chosen. Unknown keys
+// are ignored.
+func visibleColumns(req *http.Request) map[string]bool {
+	visible := map[string]bool{}
+	c, err := req.Cookie(columnsCookie)
+	if err != nil {
+		for _, col := range dashboardColumns {
+			if col.Default {
+				visible[col.Key] = true
+			}
+		}
+		return visible
+	}
+	for _, key := range strings.Split(c.Value, ",") {
+		for _, col := range dashboardColumns {
+			if key == col.Key {
+				visible[key] = true
+			}
+		}
+	}
+	return visible
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+// runCommand runs a one-shot command, such as "cockroach sql", and returns
+// its combined output. The command is killed if it runs for longer than
+// timeout, or -command-timeout if timeout is 0, so that an unresponsive
+// cluster can't wedge the handler waiting for it. A nil env runs it with
+// roachdemo's environment.
+func runCommand(timeout time.Duration, env []string, name string, args ...string) ([]byte, error) {
+	if timeout == 0 {
+		timeout = *commandTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = env
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		desc := filepath.Base(name)
+		if len(args) > 0 {
+			desc += " " + args[0]
+		}
+		return out, fmt.Errorf("command timed out after %s: %s", timeout, desc)
+	}
+	return out, err
+}
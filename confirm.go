@@ -0,0 +1,125 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// confirmTokenTTL is how long a confirmation token remains valid.
+const confirmTokenTTL = 5 * time.Minute
+
+// confirmTokens holds one-time tokens confirming destructive actions. Each
+// token is bound to the path of the action it confirms.
+type confirmTokens struct {
+	mu     sync.Mutex
+	tokens map[string]confirmToken
+}
+
+type confirmToken struct {
+	path    string
+	expires time.Time
+}
+
+func (t *confirmTokens) issue(path string) string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	token := hex.EncodeToString(b[:])
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.tokens == nil {
+		t.tokens = map[string]confirmToken{}
+	}
+	now := time.Now()
+	for k, v := range t.tokens {
+		if now.After(v.expires) {
+			delete(t.tokens, k)
+		}
+	}
+	t.tokens[token] = confirmToken{path: path, expires: now.Add(confirmTokenTTL)}
+	return token
+}
+
+// consume returns whether token is a valid token for path, invalidating it.
+func (t *confirmTokens) consume(token, path string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	v, ok := t.tokens[token]
+	if !ok {
+		return false
+	}
+	delete(t.tokens, token)
+	return v.path == path && time.Now().Before(v.expires)
+}
+
+// confirmValue is a form value of a request being confirmed, resubmitted
+// along with the confirmation.
+type confirmValue struct {
+	Name  string
+	Value string
+}
+
+// requireConfirmation wraps a destructive route so that it only runs for a
+// POST carrying a valid confirmation token. Any other request is shown a
+// confirmation page, with the warning, whose form resubmits the request with
+// a fresh token.
+func (c *cluster) requireConfirmation(action, warning string, fn routeFn) routeFn {
+	return func(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+		if req.Method == http.MethodPost && c.confirm.consume(req.FormValue("confirm"), req.URL.Path) {
+			fn(rw, req, args)
+			return
+		}
+
+		// The form values, whether from the query or the body, are shown
+		// and resubmitted as hidden fields.
+		var values []confirmValue
+		if err := req.ParseForm(); err == nil {
+			for name, vs := range req.Form {
+				if name == "confirm" {
+					continue
+				}
+				for _, v := range vs {
+					values = append(values, confirmValue{Name: name, Value: v})
+				}
+			}
+		}
+		sort.Slice(values, func(i, j int) bool { return values[i].Name < values[j].Name })
+
+		data := map[string]interface{}{
+			"Title":   "confirm",
+			"Page":    "Confirm",
+			"Cluster": c,
+			"Action":  action,
+			"Warning": warning,
+			"Path":    req.URL.Path,
+			"Values":  values,
+			"Token":   c.confirm.issue(req.URL.Path),
+			"Back":    req.Referer(),
+		}
+		renderLayout(rw, req, "confirm.html", "layout.html", "Content", data)
+	}
+}
+
+// apiConfirmed returns whether an API request for a destructive action
+// carries a valid confirmation token in its "confirm" query value. If it
+// doesn't, a fresh token for the request's path is returned to the client
+// with 428 Precondition Required, to be sent with the repeated request.
+func (c *cluster) apiConfirmed(rw http.ResponseWriter, req *http.Request, action string) bool {
+	if c.confirm.consume(req.URL.Query().Get("confirm"), req.URL.Path) {
+		return true
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusPreconditionRequired)
+	writeJSON(rw, map[string]string{
+		"error":   fmt.Sprintf("%s must be confirmed: repeat the request with ?confirm=<token>", action),
+		"confirm": c.confirm.issue(req.URL.Path),
+	})
+	return false
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// cpuSetRE matches a taskset/cpuset core list such as 0-3,6.
+var cpuSetRE = regexp.MustCompile(`^\d+(-\d+)?(,\d+(-\d+)?)*$`)
+
+// parseCPUList parses a CPU list such as 0-3,6, as taken by taskset and
+// listed by sysfs, into the set of CPUs it lists. An empty list has none.
+func parseCPUList(s string) (map[int]bool, error) {
+	cpus := map[int]bool{}
+	if s == "" {
+		return cpus, nil
+	}
+	if !cpuSetRE.MatchString(s) {
+		return nil, fmt.Errorf("invalid CPU list %q", s)
+	}
+	for _, r := range strings.Split(s, ",") {
+		lo, hi := r, r
+		if i := strings.IndexByte(r, '-'); i >= 0 {
+			lo, hi = r[:i], r[i+1:]
+		}
+		from, _ := strconv.Atoi(lo)
+		to, _ := strconv.Atoi(hi)
+		if to < from {
+			return nil, fmt.Errorf("invalid CPU range %q", r)
+		}
+		for cpu := from; cpu <= to; cpu++ {
+			cpus[cpu] = true
+		}
+	}
+	return cpus, nil
+}
+
+// checkCPUSet returns an error if the node's runs can't be pinned to cores.
+// Docker nodes use docker's --cpuset-cpus; other nodes are run under taskset,
+// which requires Linux.
+func (n *node) checkCPUSet() error {
+	if n.Container != "" {
+		return nil
+	}
+	if runtime.GOOS != "linux" {
+		return fmt.Errorf("pinning to cores requires Linux or -docker, not %s", runtime.GOOS)
+	}
+	if _, err := exec.LookPath("taskset"); err != nil {
+		return fmt.Errorf("pinning to cores requires taskset: %s", err)
+	}
+	return nil
+}
+
+// cpuEnv returns env with GOMAXPROCS set to cpus, which the Go runtime, and
+// so cockroach, uses as its number of CPUs.
+func cpuEnv(env map[string]string, cpus int) map[string]string {
+	vars := make(map[string]string, len(env)+1)
+	for k, v := range env {
+		vars[k] = v
+	}
+	vars["GOMAXPROCS"] = strconv.Itoa(cpus)
+	return vars
+}
+
+// dockerCPUArgs returns the docker run args modified to limit the container
+// to cpus CPUs, pinned to the cores in cpuSet if set. GOMAXPROCS is passed
+// into the container as the docker client's environment doesn't reach it.
+func dockerCPUArgs(args []string, cpus int, cpuSet string) []string {
+	opts := []string{fmt.Sprintf("--cpus=%d", cpus), fmt.Sprintf("--env=GOMAXPROCS=%d", cpus)}
+	if cpuSet != "" {
+		opts = append(opts, "--cpuset-cpus="+cpuSet)
+	}
+	return append(append([]string{args[0], args[1]}, opts...), args[2:]...)
+}
+
+// VCPUs returns the number of vCPUs the node's runs see: its CPU limit, or
+// all of the host's CPUs if it has none.
+func (n *node) VCPUs() int {
+	if n.CPUs > 0 {
+		return n.CPUs
+	}
+	return runtime.NumCPU()
+}
+
+// setCPUs sets the number of vCPUs of the node's runs to the "n" form value
+// via GOMAXPROCS, pinning them to the cores given by the "cores" form value
+// (e.g. 0-1) if set and supported, and restarting the node if it is running.
+// An empty or zero n removes the limit.
+func (c *cluster) setCPUs(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	var cpus int
+	if s := strings.TrimSpace(req.FormValue("n")); s != "" {
+		var err error
+		cpus, err = strconv.Atoi(s)
+		if err != nil || cpus < 0 {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, fmt.Sprintf("invalid number of vCPUs: %q", s))
+			return
+		}
+	}
+	cores := strings.TrimSpace(req.FormValue("cores"))
+	if cores != "" {
+		if cpus == 0 {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, "pinning to cores requires a number of vCPUs")
+			return
+		}
+		if !cpuSetRE.MatchString(cores) {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, fmt.Sprintf("invalid cores %q: expected a list such as 0-1,4", cores))
+			return
+		}
+		if err := t.checkCPUSet(); err != nil {
+			c.events.add(t.Name, "not pinning to cores %s: %s", cores, err)
+			cores = ""
+		}
+	}
+	if cores != "" && t.NUMANode != "" {
+		id, _ := strconv.Atoi(t.NUMANode)
+		if err := checkNUMACPUs(id, cores); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, err.Error())
+			return
+		}
+	}
+
+	if cpus != t.CPUs || cores != t.CPUSet {
+		switch {
+		case cpus == 0:
+			c.events.add(t.Name, "vCPU limit removed")
+		case cores == "":
+			c.events.add(t.Name, "vCPUs set to %d", cpus)
+		default:
+			c.events.add(t.Name, "vCPUs set to %d, pinned to cores %s", cpus, cores)
+		}
+		t.CPUs, t.CPUSet = cpus, cores
+		if t.Active() != nil {
+			t.gracefulRestart()
+		}
+	}
+
+	redirect(rw, req)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sync"
+)
+
+// demoProcess is a "cockroach demo" process managed by roachdemo. The nodes
+// it runs are not managed by roachdemo and are shown read-only.
+type demoProcess struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+
+	mu     sync.Mutex
+	webUIs []string
+	sqls   []string
+	err    error
+	exited bool
+}
+
+type demoNode struct {
+	ID    int
+	WebUI string
+	SQL   string
+}
+
+var (
+	demoWebUIRE = regexp.MustCompile(`(?:\(webui\)|Web UI:)\s+(\S+)`)
+	demoSQLRE   = regexp.MustCompile(`(?:\(sql\)|Connection string:)\s+(\S+)`)
+)
+
+// startDemo launches "cockroach demo", capturing its output in logPath and
+// parsing the connection info of its nodes from the output.
+func startDemo(logPath string) (*demoProcess, error) {
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return nil, err
+	}
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &demoProcess{
+		cmd: exec.Command(cockroachBin, "demo", "--insecure", "--no-example-database"),
+	}
+	// NB: the demo runs an interactive SQL shell which exits when its input
+	// is closed, so stdin is kept open for the life of the process.
+	d.stdin, err = d.cmd.StdinPipe()
+	if err != nil {
+		logFile.Close()
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	out := io.MultiWriter(logFile, pw)
+	d.cmd.Stdout = out
+	d.cmd.Stderr = out
+	if err := d.cmd.Start(); err != nil {
+		logFile.Close()
+		return nil, err
+	}
+	log.Printf("process %d started: cockroach demo", d.cmd.Process.Pid)
+
+	go func() {
+		s := bufio.NewScanner(pr)
+		for s.Scan() {
+			d.parse(s.Text())
+		}
+	}()
+	go func() {
+		err := d.cmd.Wait()
+		pw.Close()
+		logFile.Close()
+		log.Printf("cockroach demo exited: %v", err)
+		d.mu.Lock()
+		d.err, d.exited = err, true
+		d.mu.Unlock()
+	}()
+	return d, nil
+}
+
+func (d *demoProcess) parse(line string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if m := demoWebUIRE.FindStringSubmatch(line); m != nil {
+		d.webUIs = append(d.webUIs, m[1])
+	}
+	if m := demoSQLRE.FindStringSubmatch(line); m != nil {
+		d.sqls = append(d.sqls, m[1])
+	}
+}
+
+// Nodes returns the connection info of the demo's nodes parsed so far.
+func (d *demoProcess) Nodes() []demoNode {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	n := len(d.webUIs)
+	if len(d.sqls) > n {
+		n = len(d.sqls)
+	}
+	nodes := make([]demoNode, n)
+	for i := range nodes {
+		nodes[i].ID = i + 1
+		if i < len(d.webUIs) {
+			nodes[i].WebUI = d.webUIs[i]
+		}
+		if i < len(d.sqls) {
+			nodes[i].SQL = d.sqls[i]
+		}
+	}
+	return nodes
+}
+
+// Status returns "Running" or a description of how the demo exited.
+func (d *demoProcess) Status() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.exited {
+		return "Running"
+	}
+	if d.err != nil {
+		return "Exited: " + d.err.Error()
+	}
+	return "Exited"
+}
+
+func (d *demoProcess) stop() {
+	d.stdin.Close()
+	if d.cmd.Process != nil {
+		d.cmd.Process.Kill()
+	}
+}
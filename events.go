@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxEvents is the number of events retained by the cluster event log.
+const maxEvents = 1000
+
+// maxLongPolls is the number of /api/events long-polls which may be blocked
+// at once, and maxLongPollWait bounds how long each may block.
+const (
+	maxLongPolls    = 16
+	maxLongPollWait = 30 * time.Second
+)
+
+var longPolls = make(chan struct{}, maxLongPolls)
+
+type event struct {
+	ID      int       `json:"id"`
+	Time    time.Time `json:"time"`
+	Node    string    `json:"node,omitempty"`
+	Message string    `json:"message"`
+}
+
+// eventLog is a bounded, in-memory log of notable cluster events such as
+// upgrades.
+type eventLog struct {
+	mu     sync.Mutex
+	nextID int
+	events []event
+	// added is closed, and replaced, when an event is added.
+	added chan struct{}
+}
+
+func (l *eventLog) add(node, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if node != "" {
+		log.Printf("node %s: %s", node, msg)
+	} else {
+		log.Print(msg)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, event{
+		ID:      l.nextID,
+		Time:    time.Now(),
+		Node:    node,
+		Message: msg,
+	})
+	l.nextID++
+	if l.added != nil {
+		close(l.added)
+		l.added = nil
+	}
+	if len(l.events) > maxEvents {
+		l.events = append([]event(nil), l.events[len(l.events)-maxEvents:]...)
+	}
+}
+
+// list returns the events in the log, most recent first.
+func (l *eventLog) list() []event {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	res := make([]event, len(l.events))
+	for i, e := range l.events {
+		res[len(res)-1-i] = e
+	}
+	return res
+}
+
+// since returns the events with IDs greater than id, oldest first, along
+// with the ID of the most recent event (-1 if there are none) and a channel
+// which is closed when the next event is added.
+func (l *eventLog) since(id int) ([]event, int, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var res []event
+	for _, e := range l.events {
+		if e.ID > id {
+			res = append(res, e)
+		}
+	}
+	if l.added == nil {
+		l.added = make(chan struct{})
+	}
+	return res, l.nextID - 1, l.added
+}
+
+// latestID returns the ID of the most recent event.
+func (l *eventLog) latestID() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.nextID - 1
+}
+
+type apiEvents struct {
+	Events []event `json:"events"`
+	// ID is the ID of the most recent event, to be passed as since to the
+	// next request.
+	ID int `json:"id"`
+}
+
+// apiEvents returns the events newer than the since parameter, blocking until
+// there are some or the wait parameter (at most maxLongPollWait) elapses.
+func (c *cluster) apiEvents(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	since := -1
+	if v := req.FormValue("since"); v != "" {
+		var err error
+		if since, err = strconv.Atoi(v); err != nil {
+			http.Error(rw, fmt.Sprintf("invalid since %q", v), http.StatusBadRequest)
+			return
+		}
+	}
+	wait := maxLongPollWait
+	if v := req.FormValue("wait"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			http.Error(rw, fmt.Sprintf("invalid wait %q", v), http.StatusBadRequest)
+			return
+		}
+		if d < wait {
+			wait = d
+		}
+	}
+
+	events, id, added := c.events.since(since)
+	if len(events) == 0 && wait > 0 {
+		select {
+		case longPolls <- struct{}{}:
+			defer func() { <-longPolls }()
+		default:
+			http.Error(rw, "too many concurrent long-polls", http.StatusServiceUnavailable)
+			return
+		}
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-added:
+			events, id, _ = c.events.since(since)
+		case <-timer.C:
+		case <-req.Context().Done():
+			return
+		}
+	}
+	if events == nil {
+		events = []event{}
+	}
+	writeJSON(rw, apiEvents{Events: events, ID: id})
+}
+
+func (c *cluster) showEvents(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	data := map[string]interface{}{
+		"Title":   "events",
+		"Page":    "Events",
+		"Cluster": c,
+		"Events":  c.events.list(),
+	}
+	renderLayout(rw, req, "events.html", "layout.html", "Content", data)
+}
@@ -0,0 +1,417 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// clusterState is a portable description of a cluster's configuration,
+// excluding run history and logs.
+type clusterState struct {
+	Args     []string    `json:"args"`
+	FileArgs []string    `json:"file_args"`
+	Vmodule  string      `json:"vmodule"`
+	NextPort int         `json:"next_port"`
+	Nodes    []nodeState `json:"nodes"`
+
+	NextSQLPort int `json:"next_sql_port,omitempty"`
+	// Name is the -name of the exporting roachdemo, for reference; it isn't
+	// applied on import.
+	Name string `json:"name,omitempty"`
+}
+
+type nodeState struct {
+	Name      string            `json:"name"`
+	Args      []string          `json:"args"`
+	Env       map[string]string `json:"env"`
+	Stdout    string            `json:"stdout"`
+	Stderr    string            `json:"stderr"`
+	URL       string            `json:"url"`
+	Host      string            `json:"host"`
+	Port      int               `json:"port"`
+	HTTPPort  int               `json:"http_port"`
+	Dir       string            `json:"dir"`
+	Container string            `json:"container,omitempty"`
+	Attrs     string            `json:"attrs"`
+	Locality  string            `json:"locality"`
+	Service   bool              `json:"service"`
+	Disabled  bool              `json:"disabled"`
+
+	// SQLPort is omitted when SQL is served on Port.
+	SQLPort int `json:"sql_port,omitempty"`
+	// EnvSources are the sources of the variables of Env (see
+	// node.EnvSources). Variables without one are per-node.
+	EnvSources map[string]string `json:"env_sources,omitempty"`
+
+	Store         string `json:"store,omitempty"`
+	ReadyCommand  string `json:"ready_command,omitempty"`
+	PreStartHook  string `json:"pre_start_hook,omitempty"`
+	PostStopHook  string `json:"post_stop_hook,omitempty"`
+	MemLimit      string `json:"mem_limit,omitempty"`
+	CPUs          int    `json:"cpus,omitempty"`
+	CPUSet        string `json:"cpu_set,omitempty"`
+	NUMANode      string `json:"numa_node,omitempty"`
+	PinnedBinary  string `json:"pinned_binary,omitempty"`
+	PinnedVersion string `json:"pinned_version,omitempty"`
+}
+
+func (c *cluster) exportState() clusterState {
+	s := clusterState{
+		Args:     c.args,
+		FileArgs: c.fileArgs,
+		Vmodule:  c.Vmodule,
+		NextPort: c.NextPort,
+	}
+	s.NextSQLPort = c.NextSQLPort
+	s.Name = *demoName
+	for _, t := range c.sortedNodes() {
+		ns := nodeState{
+			Name:      t.Name,
+			Args:      t.Args,
+			Env:       t.Env,
+			Stdout:    t.Stdout,
+			Stderr:    t.Stderr,
+			URL:       t.URL,
+			Host:      t.Host,
+			Port:      t.Port,
+			HTTPPort:  t.HTTPPort,
+			Dir:       t.Dir,
+			Container: t.Container,
+			Attrs:     t.Attrs,
+			Locality:  t.Locality,
+			Service:   t.Service,
+			Disabled:  t.Disabled,
+
+			Store:         t.Store,
+			ReadyCommand:  t.ReadyCommand,
+			PreStartHook:  t.PreStartHook,
+			PostStopHook:  t.PostStopHook,
+			MemLimit:      t.MemLimit,
+			CPUs:          t.CPUs,
+			CPUSet:        t.CPUSet,
+			NUMANode:      t.NUMANode,
+			PinnedBinary:  t.PinnedBinary,
+			PinnedVersion: t.PinnedVersion,
+		}
+		if t.SQLPort != t.Port {
+			ns.SQLPort = t.SQLPort
+		}
+		ns.EnvSources = t.EnvSources
+		s.Nodes = append(s.Nodes, ns)
+	}
+	return s
+}
+
+// importBinaries returns the binaries the nodes of an imported cluster may
+// run: those roachdemo runs nodes with itself and those of the current
+// nodes. An import can't otherwise choose the commands roachdemo runs.
+func (c *cluster) importBinaries() map[string]bool {
+	bins := map[string]bool{cockroachBin: true}
+	if *raceBinary != "" {
+		bins[*raceBinary] = true
+	}
+	if *dockerImage != "" {
+		bins["docker"] = true
+	}
+	for _, t := range c.Nodes {
+		bins[t.Args[0]] = true
+	}
+	return bins
+}
+
+// importCommands returns the shell commands the nodes of an imported cluster
+// may run as readiness commands and hooks: none, those given to roachdemo
+// and those of the current nodes.
+func (c *cluster) importCommands() map[string]bool {
+	cmds := map[string]bool{"": true, *readyCmd: true, *preStartHook: true, *postStopHook: true}
+	for _, t := range c.Nodes {
+		cmds[t.ReadyCommand] = true
+		cmds[t.PreStartHook] = true
+		cmds[t.PostStopHook] = true
+	}
+	return cmds
+}
+
+// loaderEnvPrefixes are the prefixes of the environment variables of the
+// dynamic loader, which can load code into any binary, e.g. LD_PRELOAD.
+var loaderEnvPrefixes = []string{"LD_", "DYLD_"}
+
+// inDataDir returns whether path is inside the data directory holding the
+// nodes' directories.
+func inDataDir(path string) bool {
+	base, err := filepath.Abs(dataDir)
+	if err != nil {
+		return false
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(base, abs)
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// validateImportArgs returns an error if args, which are appended to the
+// args of each node, aren't all flags or set any of the managedFlags.
+func validateImportArgs(args []string) error {
+	v := validateArgs(nil, args, nil)
+	if len(v.Problems) > 0 {
+		return fmt.Errorf("%s: %s", v.Problems[0].Arg, v.Problems[0].Problem)
+	}
+	if len(v.Warnings) > 0 {
+		return fmt.Errorf("%s: set by roachdemo for each node", v.Warnings[0].Arg)
+	}
+	return nil
+}
+
+// validateArgs returns an error if the args of the node don't run one of
+// the binaries as "start" with only flags, or if their port flags don't
+// match the node's ports.
+func (n nodeState) validateArgs(binaries map[string]bool) error {
+	if !binaries[n.Args[0]] {
+		return fmt.Errorf("binary %s isn't allowed", n.Args[0])
+	}
+	start := -1
+	for i, arg := range n.Args {
+		if arg == "start" {
+			start = i
+			break
+		}
+	}
+	switch {
+	case start < 0:
+		return fmt.Errorf("args don't start cockroach")
+	case n.Args[0] == "docker":
+		if n.Args[start-1] != *dockerImage {
+			return fmt.Errorf("docker image %s isn't allowed", n.Args[start-1])
+		}
+	case start != 1:
+		return fmt.Errorf("args don't start cockroach")
+	}
+	if v := validateArgs(nil, n.Args[start+1:], nil); len(v.Problems) > 0 {
+		return fmt.Errorf("%s: %s", v.Problems[0].Arg, v.Problems[0].Problem)
+	}
+
+	sqlPort := n.SQLPort
+	if sqlPort == 0 {
+		sqlPort = n.Port
+	}
+	for _, p := range argPorts(n.Args[start+1:]) {
+		if p.Port != n.Port && p.Port != n.HTTPPort && p.Port != sqlPort {
+			return fmt.Errorf("%s port %d isn't one of the node's ports", p.Flag, p.Port)
+		}
+	}
+	return nil
+}
+
+// validateConfig returns an error if the node's environment sets loader
+// variables, its directory, store or logs aren't in the data directory, as
+// those of roachdemo's own nodes are, or its settings are invalid. Its
+// readiness command and hooks must be among the commands and its pinned
+// binary among the binaries.
+func (n nodeState) validateConfig(binaries, commands map[string]bool) error {
+	for name := range n.Env {
+		for _, prefix := range loaderEnvPrefixes {
+			if strings.HasPrefix(name, prefix) {
+				return fmt.Errorf("env %s isn't allowed", name)
+			}
+		}
+	}
+	// The log paths are checked as expanded by the node's environment.
+	t := newNode(n.Name, nil, n.Env, false, n.Stdout, n.Stderr, "", "")
+	t.Dir, t.Store, t.Container = n.Dir, n.Store, n.Container
+	if !inDataDir(t.Dir) {
+		return fmt.Errorf("dir %s isn't in %s", t.Dir, dataDir)
+	}
+	if dir := t.StoreDir(); dir != "" && !inDataDir(dir) {
+		return fmt.Errorf("store %s isn't in %s", dir, dataDir)
+	}
+	for _, path := range []string{t.Stdout, t.Stderr} {
+		if path != "" && !inDataDir(path) {
+			return fmt.Errorf("log %s isn't in %s", path, dataDir)
+		}
+	}
+
+	for _, cmd := range []string{n.ReadyCommand, n.PreStartHook, n.PostStopHook} {
+		if !commands[cmd] {
+			return fmt.Errorf("command %q isn't allowed", cmd)
+		}
+	}
+	if n.PinnedBinary != "" && !binaries[n.PinnedBinary] {
+		return fmt.Errorf("pinned binary %s isn't allowed", n.PinnedBinary)
+	}
+	if n.MemLimit != "" {
+		if _, err := parseByteSize(n.MemLimit); err != nil {
+			return fmt.Errorf("invalid memory limit %q: %s", n.MemLimit, err)
+		}
+	}
+	if n.CPUs < 0 {
+		return fmt.Errorf("invalid number of vCPUs %d", n.CPUs)
+	}
+	if n.CPUSet != "" && (n.CPUs == 0 || !cpuSetRE.MatchString(n.CPUSet)) {
+		return fmt.Errorf("invalid cores %q", n.CPUSet)
+	}
+	if n.NUMANode != "" {
+		if id, err := strconv.Atoi(n.NUMANode); err != nil || id < 0 {
+			return fmt.Errorf("invalid NUMA node %q", n.NUMANode)
+		}
+	}
+	return nil
+}
+
+// validate returns an error if the state can't be imported. The nodes may
+// only run the binaries and the shell commands.
+func (s *clusterState) validate(binaries, commands map[string]bool) error {
+	if err := validateImportArgs(s.Args); err != nil {
+		return fmt.Errorf("args: %s", err)
+	}
+	if err := validateImportArgs(s.FileArgs); err != nil {
+		return fmt.Errorf("file args: %s", err)
+	}
+	names := map[string]bool{}
+	ports := map[int]string{}
+	for _, n := range s.Nodes {
+		if n.Name == "" {
+			return fmt.Errorf("node without a name")
+		}
+		if names[n.Name] {
+			return fmt.Errorf("duplicate node %s", n.Name)
+		}
+		names[n.Name] = true
+		if len(n.Args) == 0 {
+			return fmt.Errorf("node %s: no args", n.Name)
+		}
+		if err := n.validateArgs(binaries); err != nil {
+			return fmt.Errorf("node %s: %s", n.Name, err)
+		}
+		if err := n.validateConfig(binaries, commands); err != nil {
+			return fmt.Errorf("node %s: %s", n.Name, err)
+		}
+		nodePorts := []int{n.Port, n.HTTPPort}
+		if n.SQLPort != 0 {
+			nodePorts = append(nodePorts, n.SQLPort)
+		}
+		for _, port := range nodePorts {
+			if port <= 0 || port > 65535 {
+				return fmt.Errorf("node %s: invalid port %d", n.Name, port)
+			}
+			if other, ok := ports[port]; ok {
+				return fmt.Errorf("node %s: port %d already used by node %s", n.Name, port, other)
+			}
+			ports[port] = n.Name
+		}
+	}
+	return nil
+}
+
+// importState replaces the cluster's configuration and nodes with the
+// specified state, starting the nodes which are services. A node which fails
+// to start is discarded as by newNode.
+func (c *cluster) importState(s clusterState) {
+	for _, t := range c.Nodes {
+		t.stopService()
+	}
+	c.Nodes = map[string]*node{}
+	c.NextNodeID = 1
+	c.args = s.Args
+	c.fileArgs = s.FileArgs
+	c.Vmodule = s.Vmodule
+	c.NextPort = s.NextPort
+	c.NextSQLPort = s.NextSQLPort
+
+	for _, ns := range s.Nodes {
+		t := newNode(ns.Name, ns.Args, ns.Env, false, ns.Stdout, ns.Stderr, ns.Attrs, ns.Locality)
+		for name, source := range ns.EnvSources {
+			if _, ok := t.EnvSources[name]; ok && (source == envInherited || source == envDefault) {
+				t.EnvSources[name] = source
+			}
+		}
+		t.URL = ns.URL
+		t.Host = ns.Host
+		t.Port = ns.Port
+		t.SQLPort = ns.SQLPort
+		if t.SQLPort == 0 {
+			t.SQLPort = ns.Port
+		}
+		t.HTTPPort = ns.HTTPPort
+		t.Dir = ns.Dir
+		t.Container = ns.Container
+		t.Disabled = ns.Disabled
+		t.Store = ns.Store
+		t.ReadyCommand = ns.ReadyCommand
+		t.PreStartHook = ns.PreStartHook
+		t.PostStopHook = ns.PostStopHook
+		t.MemLimit = ns.MemLimit
+		t.CPUs = ns.CPUs
+		t.CPUSet = ns.CPUSet
+		t.NUMANode = ns.NUMANode
+		t.PinnedBinary = ns.PinnedBinary
+		t.PinnedVersion = ns.PinnedVersion
+		if err := t.loadNotes(); err != nil {
+			log.Printf("node %s: unable to load notes: %s", t.Name, err)
+		}
+		c.Nodes[t.Name] = t
+		if id, err := strconv.Atoi(t.Name); err == nil && id >= c.NextNodeID {
+			c.NextNodeID = id + 1
+		}
+		if c.NextPort <= ns.HTTPPort {
+			c.NextPort = ns.HTTPPort + 1
+		}
+		if c.NextSQLPort != 0 && c.NextSQLPort <= ns.SQLPort {
+			c.NextSQLPort = ns.SQLPort + 1
+		}
+		if ns.Service {
+			t.startService()
+			if err := t.startError(); err != nil {
+				c.discardNode(t)
+				log.Printf("node %s: unable to start: %s", t.Name, err)
+				c.events.add(t.Name, "unable to start imported node: %s", err)
+			}
+		}
+	}
+}
+
+func (c *cluster) apiExport(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	rw.Header().Set("Content-Disposition", `attachment; filename="cluster.json"`)
+	writeJSON(rw, c.exportState())
+}
+
+func (c *cluster) apiImport(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "import requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	// Requiring JSON keeps a cross-site form from posting an import.
+	if mt, _, err := mime.ParseMediaType(req.Header.Get("Content-Type")); err != nil || mt != "application/json" {
+		http.Error(rw, "import requires Content-Type application/json", http.StatusUnsupportedMediaType)
+		return
+	}
+	var s clusterState
+	if err := json.NewDecoder(req.Body).Decode(&s); err != nil {
+		http.Error(rw, fmt.Sprintf("invalid cluster state: %s", err), http.StatusBadRequest)
+		return
+	}
+	if err := s.validate(c.importBinaries(), c.importCommands()); err != nil {
+		http.Error(rw, fmt.Sprintf("invalid cluster state: %s", err), http.StatusBadRequest)
+		return
+	}
+	if len(c.Nodes) > 0 {
+		if req.URL.Query().Get("replace") != "true" {
+			http.Error(rw, "cluster already has nodes, use ?replace=true to replace them", http.StatusConflict)
+			return
+		}
+		if !c.apiConfirmed(rw, req, "replacing the cluster's nodes") {
+			return
+		}
+	}
+
+	c.importState(s)
+	c.events.add("", "imported cluster with %d nodes", len(s.Nodes))
+	writeJSON(rw, c.exportState())
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestImport(t *testing.T) {
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	state := func(mutate func(*clusterState)) []byte {
+		s := clusterState{
+			NextPort: 26259,
+			Nodes: []nodeState{{
+				Name:     "1",
+				Args:     []string{cockroachBin, "start", "--insecure", "--port=26257", "--http-addr=localhost:26258"},
+				Port:     26257,
+				HTTPPort: 26258,
+				Dir:      "cockroach-data/1",
+				Stdout:   "cockroach-data/1/logs/${RUN}.stdout",
+				Stderr:   "cockroach-data/1/logs/${RUN}.stderr",
+				MemLimit: "1GiB",
+				CPUs:     2,
+			}},
+		}
+		if mutate != nil {
+			mutate(&s)
+		}
+		b, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	post := func(url, contentType string, body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", url, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		rw := httptest.NewRecorder()
+		c.apiImport(rw, req, nil)
+		return rw
+	}
+
+	testCases := []struct {
+		name        string
+		contentType string
+		mutate      func(*clusterState)
+		code        int
+	}{
+		{"form", "application/x-www-form-urlencoded", nil, http.StatusUnsupportedMediaType},
+		{"binary override", "application/json", func(s *clusterState) {
+			s.Nodes[0].Args[0] = "/bin/sh"
+		}, http.StatusBadRequest},
+		{"positional arg", "application/json", func(s *clusterState) {
+			s.Nodes[0].Args = append(s.Nodes[0].Args, "-c", "true")
+		}, http.StatusBadRequest},
+		{"managed flag in cluster args", "application/json", func(s *clusterState) {
+			s.Args = []string{"--store=/tmp/elsewhere"}
+		}, http.StatusBadRequest},
+		{"undeclared port", "application/json", func(s *clusterState) {
+			s.Nodes[0].Args = append(s.Nodes[0].Args, "--sql-addr=localhost:5432")
+		}, http.StatusBadRequest},
+		{"loader env", "application/json", func(s *clusterState) {
+			s.Nodes[0].Env = map[string]string{"LD_PRELOAD": "/tmp/x.so"}
+		}, http.StatusBadRequest},
+		{"log outside", "application/json", func(s *clusterState) {
+			s.Nodes[0].Stdout = "/home/u/.bashrc"
+		}, http.StatusBadRequest},
+		{"log expanded outside", "application/json", func(s *clusterState) {
+			s.Nodes[0].Env = map[string]string{"X": "/home/u"}
+			s.Nodes[0].Stderr = "${X}/.bashrc"
+		}, http.StatusBadRequest},
+		{"dir outside", "application/json", func(s *clusterState) {
+			s.Nodes[0].Dir = "cockroach-data/../.."
+		}, http.StatusBadRequest},
+		{"store outside", "application/json", func(s *clusterState) {
+			s.Nodes[0].Store = "/home/u"
+		}, http.StatusBadRequest},
+		{"hook", "application/json", func(s *clusterState) {
+			s.Nodes[0].PreStartHook = "touch /tmp/pwned"
+		}, http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		if rw := post("/api/import", tc.contentType, state(tc.mutate)); rw.Code != tc.code {
+			t.Errorf("%s: expected %d, got %d: %s", tc.name, tc.code, rw.Code, rw.Body)
+		}
+	}
+	if len(c.Nodes) != 0 {
+		t.Fatalf("expected rejected imports not to create nodes, got %d", len(c.Nodes))
+	}
+
+	if rw := post("/api/import", "application/json; charset=utf-8", state(nil)); rw.Code != http.StatusOK {
+		t.Fatalf("expected the import to succeed, got %d: %s", rw.Code, rw.Body)
+	}
+	if len(c.Nodes) != 1 {
+		t.Fatalf("expected 1 node, got %d", len(c.Nodes))
+	}
+	if n := c.Nodes["1"]; n.MemLimit != "1GiB" || n.CPUs != 2 {
+		t.Fatalf("expected the node's config to be imported, got memory limit %q and %d vCPUs", n.MemLimit, n.CPUs)
+	}
+
+	// Replacing the nodes requires ?replace=true and a confirmation token.
+	if rw := post("/api/import", "application/json", state(nil)); rw.Code != http.StatusConflict {
+		t.Fatalf("expected a conflict, got %d: %s", rw.Code, rw.Body)
+	}
+	rw := post("/api/import?replace=true", "application/json", state(nil))
+	if rw.Code != http.StatusPreconditionRequired {
+		t.Fatalf("expected a confirmation to be required, got %d: %s", rw.Code, rw.Body)
+	}
+	var resp struct{ Confirm string }
+	if err := json.Unmarshal(rw.Body.Bytes(), &resp); err != nil || resp.Confirm == "" {
+		t.Fatalf("expected a confirmation token: %s, %v", rw.Body, err)
+	}
+	if rw := post("/api/import?replace=true&confirm="+resp.Confirm, "application/json", state(nil)); rw.Code != http.StatusOK {
+		t.Fatalf("expected the confirmed replace to succeed, got %d: %s", rw.Code, rw.Body)
+	}
+	if rw := post("/api/import?replace=true&confirm="+resp.Confirm, "application/json", state(nil)); rw.Code != http.StatusPreconditionRequired {
+		t.Fatalf("expected the token not to be reusable, got %d: %s", rw.Code, rw.Body)
+	}
+}
+
+func TestImportFailedStartDiscarded(t *testing.T) {
+	defer func(bin string) { cockroachBin = bin }(cockroachBin)
+	cockroachBin = "/nonexistent/cockroach"
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	c.importState(clusterState{
+		NextPort: 26259,
+		Nodes: []nodeState{{
+			Name:     "1",
+			Args:     []string{cockroachBin, "start", "--insecure"},
+			Port:     26257,
+			HTTPPort: 26258,
+			Dir:      "cockroach-data/1",
+			Service:  true,
+		}},
+	})
+	if len(c.Nodes) != 0 {
+		t.Fatalf("expected the node which failed to start to be discarded, got %d nodes", len(c.Nodes))
+	}
+}
+
+func TestImportEnvSources(t *testing.T) {
+	inTempDir(t)
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	n, err := c.createNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.Env["COCKROACH_SKIP_ENABLING_DIAGNOSTIC_REPORTING"] = "true"
+	n.EnvSources["COCKROACH_SKIP_ENABLING_DIAGNOSTIC_REPORTING"] = envOverride
+	s := c.exportState()
+
+	imported := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	imported.importState(s)
+	got := imported.Nodes[n.Name].EnvSources
+	if !reflect.DeepEqual(got, n.EnvSources) {
+		t.Fatalf("expected the sources of the node's env to survive, got %v, want %v", got, n.EnvSources)
+	}
+	if got["HOME"] != envDefault {
+		t.Fatalf("expected HOME to remain a default, got %q", got["HOME"])
+	}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// readyProbeInterval is how often a node is probed while waiting for it
+	// to become ready, and readyProbeTimeout bounds each probe.
+	readyProbeInterval = 500 * time.Millisecond
+	readyProbeTimeout  = 2 * time.Second
+	// maxWaitReady bounds how long /api/nodes/.../wait-ready may block.
+	maxWaitReady = 5 * time.Minute
+	// healthInterval is how often running nodes are probed to update their
+	// health (see probeHealth).
+	healthInterval = 5 * time.Second
+)
+
+// healthState is the outcome of the latest readiness probe of a node's run.
+type healthState struct {
+	mu  sync.Mutex
+	run *nodeRun
+	err error
+}
+
+// Healthy returns true if the latest readiness probe of the node's active run
+// succeeded.
+func (n *node) Healthy() bool {
+	r := n.Active()
+	n.health.mu.Lock()
+	defer n.health.mu.Unlock()
+	return r != nil && n.health.run == r && n.health.err == nil
+}
+
+// HealthError returns the error of the latest readiness probe of the node's
+// active run, or "" if it succeeded or the run hasn't been probed yet.
+func (n *node) HealthError() string {
+	r := n.Active()
+	n.health.mu.Lock()
+	defer n.health.mu.Unlock()
+	if r == nil || n.health.run != r || n.health.err == nil {
+		return ""
+	}
+	return n.health.err.Error()
+}
+
+// checkHealth probes the node's readiness with probeReady and records the
+// result as the health of the active run. A probe cut short by ctx isn't
+// recorded.
+func (n *node) checkHealth(ctx context.Context) error {
+	r := n.Active()
+	err := n.probeReady(ctx)
+	if r != nil && ctx.Err() == nil {
+		n.health.mu.Lock()
+		n.health.run, n.health.err = r, err
+		n.health.mu.Unlock()
+	}
+	return err
+}
+
+// probeHealth periodically probes the running nodes, so that their health
+// reflects their readiness even when nobody waits for it.
+func (c *cluster) probeHealth() {
+	for range time.Tick(healthInterval) {
+		var wg sync.WaitGroup
+		for _, t := range c.sortedNodes() {
+			if t.Status() != "Running" {
+				continue
+			}
+			wg.Add(1)
+			go func(t *node) {
+				defer wg.Done()
+				_ = t.checkHealth(context.Background())
+			}(t)
+		}
+		wg.Wait()
+	}
+}
+
+// readyVars returns the variables ReadyCommand is expanded with: the node's
+// environment plus HOST, PORT, SQL_PORT and HTTP_PORT. The values are quoted
+// for the shell, so that they can't inject commands.
+func (n *node) readyVars() map[string]string {
+	vars := make(map[string]string, len(n.Env)+4)
+	for k, v := range n.Env {
+		vars[k] = shellQuote(v)
+	}
+	vars["HOST"] = shellQuote(n.Host)
+	vars["PORT"] = strconv.Itoa(n.Port)
+	vars["SQL_PORT"] = strconv.Itoa(n.SQLPort)
+	vars["HTTP_PORT"] = strconv.Itoa(n.HTTPPort)
+	return vars
+}
+
+// shellQuote quotes s as a single word for /bin/sh.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// probeReady returns nil if the node is ready to serve, as determined by its
+// ReadyCommand if set and by its /health?ready=1 endpoint otherwise. A
+// zombie whose health is lied about is always ready.
+func (n *node) probeReady(ctx context.Context) error {
+	if r := n.Active(); r != nil && r.HealthLie {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, n.runTimeout(readyProbeTimeout))
+	defer cancel()
+	if n.ReadyCommand != "" {
+		cmd := exec.CommandContext(ctx, "/bin/sh", "-c", replaceVars(n.ReadyCommand, n.readyVars()))
+		if out, err := cmd.CombinedOutput(); err != nil {
+			if out = bytes.TrimSpace(out); len(out) > 0 {
+				return fmt.Errorf("%s: %s", err, out)
+			}
+			return err
+		}
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.URL+"/health?ready=1", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s", resp.Status)
+	}
+	return nil
+}
+
+// waitReady blocks until the node is ready or ctx is done, returning the
+// error of the last completed probe in the latter case.
+func (n *node) waitReady(ctx context.Context) error {
+	ticker := time.NewTicker(readyProbeInterval)
+	defer ticker.Stop()
+	var lastErr error
+	for {
+		err := n.checkHealth(ctx)
+		if err == nil {
+			return nil
+		}
+		// A probe cut short by ctx says nothing about the node.
+		if ctx.Err() == nil || lastErr == nil {
+			lastErr = err
+		}
+		select {
+		case <-ctx.Done():
+			return lastErr
+		case <-ticker.C:
+		}
+	}
+}
+
+// setReadyCommand sets the node's ReadyCommand from the form value "cmd",
+// with an empty value restoring health endpoint probing. As roachdemo runs
+// the command, it is routed through requireConfirmation.
+func (c *cluster) setReadyCommand(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	t.ReadyCommand = strings.TrimSpace(req.FormValue("cmd"))
+
+	redirect(rw, req)
+}
+
+// apiWaitReady blocks until the node is ready, responding with 200, or until
+// the timeout parameter (default 30s, longer for the race binary) elapses,
+// responding with 504.
+func (c *cluster) apiWaitReady(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t, ok := c.Nodes[args["node"]]
+	if !ok {
+		http.Error(rw, fmt.Sprintf("node %s not found", args["node"]), http.StatusNotFound)
+		return
+	}
+	timeout := t.runTimeout(30 * time.Second)
+	if v := req.FormValue("timeout"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 || d > maxWaitReady {
+			http.Error(rw, fmt.Sprintf("invalid timeout %q: must be a positive duration of at most %s", v, maxWaitReady),
+				http.StatusBadRequest)
+			return
+		}
+		timeout = d
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	defer cancel()
+	if err := t.waitReady(ctx); err != nil {
+		if req.Context().Err() != nil {
+			return
+		}
+		http.Error(rw, fmt.Sprintf("node %s not ready after %s: %s", t.Name, timeout, err), http.StatusGatewayTimeout)
+		return
+	}
+	writeJSON(rw, map[string]string{"node": t.Name, "status": "ready"})
+}
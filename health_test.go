@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestReadyCommandQuoting(t *testing.T) {
+	n := newNode("1", []string{"/bin/true"}, map[string]string{"X": "; exit 1", "Y": "it's"}, false, "", "", "", "")
+	n.Host = "localhost"
+	n.ReadyCommand = `[ $X = '; exit 1' ] && [ $Y = "it's" ] && [ $HOST = localhost ]`
+	if err := n.probeReady(context.Background()); err != nil {
+		t.Fatalf("expected the substituted variables to be quoted: %s", err)
+	}
+}
+
+func TestSetReadyCommandConfirmation(t *testing.T) {
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	n := newNode("1", []string{"/bin/true"}, nil, false, "", "", "", "")
+	c.Nodes[n.Name] = n
+	route := c.requireConfirmation("set readiness command", "", c.setReadyCommand)
+	args := map[string]string{"node": "1"}
+
+	rw := httptest.NewRecorder()
+	route(rw, httptest.NewRequest("GET", "/node/1/ready-cmd?cmd=true", nil), args)
+	if n.ReadyCommand != "" {
+		t.Fatalf("expected a GET not to set the command")
+	}
+	body := rw.Body.String()
+	i := strings.Index(body, `name="confirm" value="`)
+	if i < 0 {
+		t.Fatalf("expected a confirmation page, got %s", body)
+	}
+	token := body[i+len(`name="confirm" value="`):]
+	token = token[:strings.IndexByte(token, '"')]
+
+	form := url.Values{"cmd": {"true"}, "confirm": {token}}
+	req := httptest.NewRequest("POST", "/node/1/ready-cmd", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	route(httptest.NewRecorder(), req, args)
+	if n.ReadyCommand != "true" {
+		t.Fatalf("expected the confirmed POST to set the command, got %q", n.ReadyCommand)
+	}
+}
+
+func TestReadyCommandHealth(t *testing.T) {
+	n := newNode("1", []string{"/bin/true"}, nil, false, "", "", "", "")
+	n.active = &nodeRun{}
+	n.ReadyCommand = "echo starting; false"
+	if err := n.checkHealth(context.Background()); err == nil {
+		t.Fatalf("expected the failing command to fail the probe")
+	}
+	if n.Healthy() || !strings.Contains(n.HealthError(), "starting") {
+		t.Fatalf("expected the node to be unhealthy, got %q", n.HealthError())
+	}
+
+	n.ReadyCommand = "true"
+	if err := n.checkHealth(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Healthy() {
+		t.Fatalf("expected the node to be healthy")
+	}
+
+	// The health of a previous run doesn't carry over.
+	n.active = &nodeRun{}
+	if n.Healthy() {
+		t.Fatalf("expected a new run not to be healthy until probed")
+	}
+}
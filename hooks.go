@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// hookTimeout is how long a hook may run before it is killed.
+const hookTimeout = time.Minute
+
+// hooksLog returns the path of the log the output of the node's hooks is
+// appended to.
+func (n *node) hooksLog() string {
+	return filepath.Join(n.Dir, "logs", "hooks.log")
+}
+
+// runHook runs a hook command, if set, with /bin/sh around the specified run
+// of the node, appending its output to the node's hooks log. The command
+// inherits the run's environment plus HOOK, NODE_ID, RUN, HOST, PORT,
+// SQL_PORT and HTTP_PORT.
+func (n *node) runHook(hook, command string, run int, env map[string]string) error {
+	if command == "" {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	cmd.Env = os.Environ()
+	for k, v := range env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.Env = append(cmd.Env,
+		"HOOK="+hook,
+		"NODE_ID="+n.Name,
+		"RUN="+strconv.Itoa(run),
+		"HOST="+n.Host,
+		"PORT="+strconv.Itoa(n.Port),
+		"SQL_PORT="+strconv.Itoa(n.SQLPort),
+		"HTTP_PORT="+strconv.Itoa(n.HTTPPort),
+	)
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() != nil {
+		err = fmt.Errorf("timed out after %s", hookTimeout)
+	}
+
+	status := "ok"
+	if err != nil {
+		status = err.Error()
+	}
+	f, ferr := os.OpenFile(n.hooksLog(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if ferr == nil {
+		_, ferr = fmt.Fprintf(f, "=== %s %s run %d: %s\n%s=== %s\n",
+			time.Now().Format(time.RFC3339), hook, run, command, out, status)
+		if cerr := f.Close(); ferr == nil {
+			ferr = cerr
+		}
+	}
+	if ferr != nil {
+		log.Printf("node %s: unable to log %s hook: %s", n.Name, hook, ferr)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s hook: %s", hook, err)
+	}
+	return nil
+}
+
+func (c *cluster) nodeHooksLog(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	b, err := ioutil.ReadFile(t.hooksLog())
+	if err != nil && !os.IsNotExist(err) {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":     "Node hooks",
+		"Page":      "NodeOutput",
+		"Type":      "hooks",
+		"Cluster":   c,
+		"Node":      t,
+		"LogOutput": logOutput(req, string(b)),
+		"Color":     req.FormValue("color") == "true",
+	}
+
+	renderLayout(rw, req, "log.html", "layout.html", "Content", data)
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// hostSample is the resource usage of the host machine, shown on the
+// dashboard so that presenters notice when the host rather than cockroach is
+// the bottleneck. Usage which can't be read on the host's OS is left unset.
+type hostSample struct {
+	// CPU is the percentage of all of the host's cores in use.
+	CPU    float64
+	HasCPU bool
+	// MemUsed and MemTotal are in bytes; MemTotal is 0 if unknown.
+	MemUsed  int64
+	MemTotal int64
+	// DiskFree and DiskTotal are the space on the data directory's
+	// filesystem in bytes; DiskTotal is 0 if unknown.
+	DiskFree  int64
+	DiskTotal int64
+}
+
+// Mem describes the host's memory usage, e.g. "3.2 GiB / 16 GiB".
+func (s *hostSample) Mem() string {
+	return fmt.Sprintf("%s / %s", humanizeBytes(s.MemUsed), humanizeBytes(s.MemTotal))
+}
+
+// MemPercent is the percentage of the host's memory in use.
+func (s *hostSample) MemPercent() float64 {
+	return float64(s.MemUsed) / float64(s.MemTotal) * 100
+}
+
+// Disk describes the free space on the data directory's filesystem.
+func (s *hostSample) Disk() string {
+	return humanizeBytes(s.DiskFree)
+}
+
+// DiskPercentFree is the percentage of the data directory's filesystem
+// which is free.
+func (s *hostSample) DiskPercentFree() float64 {
+	return float64(s.DiskFree) / float64(s.DiskTotal) * 100
+}
+
+// hostStats caches the latest sample of the host's resource usage, taken by
+// sampleResources, so that rendering the dashboard never reads /proc.
+type hostStats struct {
+	mu     sync.Mutex
+	latest *hostSample
+	// idleTicks and totalTicks are the host's cumulative cpu times at the
+	// previous sample, from which the next sample's usage is computed.
+	idleTicks  int64
+	totalTicks int64
+}
+
+// procCPUTicks returns the host's cumulative idle and total cpu times in
+// clock ticks from /proc/stat. It fails on hosts without /proc.
+func procCPUTicks() (idle, total int64, err error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil {
+		return 0, 0, err
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 5 || fields[0] != "cpu" {
+		return 0, 0, fmt.Errorf("malformed /proc/stat")
+	}
+	// The fields are user, nice, system, idle, iowait, irq, softirq, steal,
+	// guest and guest_nice. Guest time is included in user time.
+	for i, field := range fields[1:] {
+		if i >= 8 {
+			break
+		}
+		v, _ := strconv.ParseInt(field, 10, 64)
+		total += v
+		if i == 3 || i == 4 {
+			idle += v
+		}
+	}
+	return idle, total, nil
+}
+
+// procMemInfo returns the host's used and total memory in bytes from
+// /proc/meminfo. Memory which is available for reuse, such as the page
+// cache, isn't counted as used.
+func procMemInfo() (used, total int64, err error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	var available int64 = -1
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		kb, _ := strconv.ParseInt(fields[1], 10, 64)
+		switch fields[0] {
+		case "MemTotal:":
+			total = kb << 10
+		case "MemAvailable:":
+			available = kb << 10
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+	if total == 0 || available < 0 {
+		return 0, 0, fmt.Errorf("malformed /proc/meminfo")
+	}
+	return total - available, total, nil
+}
+
+// sample records the host's current resource usage.
+func (h *hostStats) sample() {
+	s := &hostSample{}
+	idle, total, cpuErr := procCPUTicks()
+	if used, total, err := procMemInfo(); err == nil {
+		s.MemUsed, s.MemTotal = used, total
+	}
+	var st syscall.Statfs_t
+	dir := dataDir
+	if _, err := os.Stat(dir); err != nil {
+		dir = "."
+	}
+	if err := syscall.Statfs(dir, &st); err == nil {
+		s.DiskFree = int64(st.Bavail) * int64(st.Bsize)
+		s.DiskTotal = int64(st.Blocks) * int64(st.Bsize)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if cpuErr == nil {
+		if h.totalTicks > 0 && total > h.totalTicks {
+			s.CPU = 100 - float64(idle-h.idleTicks)/float64(total-h.totalTicks)*100
+			s.HasCPU = true
+		}
+		h.idleTicks, h.totalTicks = idle, total
+	}
+	h.latest = s
+}
+
+// Host returns the latest sample of the host's resource usage, or nil if
+// -host-stats isn't set or no sample has been taken yet.
+func (c *cluster) Host() *hostSample {
+	c.hostUsage.mu.Lock()
+	defer c.hostUsage.mu.Unlock()
+	return c.hostUsage.latest
+}
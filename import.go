@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// storeNodeIDRE matches the node ID in the banner cockroach prints, to its
+// stdout and its logs, when a node starts.
+var storeNodeIDRE = regexp.MustCompile(`nodeID:\s+(\d+)`)
+
+// maxNodeIDScan bounds how much of each log file is searched for the node
+// ID of an imported store.
+const maxNodeIDScan = 1 << 20
+
+// importedStore is an existing cockroach store found by scanStores.
+type importedStore struct {
+	Path string
+	// NodeID is the ID of the node which last ran on the store, or 0 if it
+	// couldn't be found in the store's logs.
+	NodeID   int
+	Warnings []string
+}
+
+// isStoreDir returns whether dir has the markers of a cockroach store: the
+// CURRENT file of its storage engine and the STORAGE_MIN_VERSION (or, for
+// old versions, COCKROACHDB_VERSION) file written by cockroach.
+func isStoreDir(dir string) (current, version bool) {
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+	return exists("CURRENT"), exists("STORAGE_MIN_VERSION") || exists("COCKROACHDB_VERSION")
+}
+
+// storeNodeID returns the node ID recorded in the logs written to the store
+// directory, by cockroach itself or by roachdemo, or 0 if there is none.
+func storeNodeID(dir string) int {
+	paths, _ := filepath.Glob(filepath.Join(dir, "logs", "*"))
+	sort.Strings(paths)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		scanner := bufio.NewScanner(io.LimitReader(f, maxNodeIDScan))
+		for scanner.Scan() {
+			if m := storeNodeIDRE.FindStringSubmatch(scanner.Text()); m != nil {
+				f.Close()
+				id, _ := strconv.Atoi(m[1])
+				return id
+			}
+		}
+		f.Close()
+	}
+	return 0
+}
+
+// storeInUse returns whether a process, presumably a cockroach node, holds
+// the lock of the store's storage engine.
+func storeInUse(dir string) bool {
+	f, err := os.Open(filepath.Join(dir, "LOCK"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		return err == syscall.EWOULDBLOCK
+	}
+	_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	return false
+}
+
+// scanStores returns the cockroach stores in dir, which may itself be a
+// store or contain one per subdirectory, ordered by path. Stores which
+// look incompatible with roachdemo or the current cockroach are returned
+// with warnings; directories which are only partially stores are logged and
+// skipped.
+func scanStores(dir string) ([]importedStore, error) {
+	dirs := []string{dir}
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range infos {
+		if fi.IsDir() {
+			dirs = append(dirs, filepath.Join(dir, fi.Name()))
+		}
+	}
+
+	var stores []importedStore
+	for _, d := range dirs {
+		current, version := isStoreDir(d)
+		if !current {
+			if version {
+				log.Printf("import: skipping %s: it has a cockroach version marker but no CURRENT file", d)
+			}
+			continue
+		}
+		abs, err := filepath.Abs(d)
+		if err != nil {
+			return nil, err
+		}
+		s := importedStore{Path: abs, NodeID: storeNodeID(d)}
+		warn := func(format string, args ...interface{}) {
+			s.Warnings = append(s.Warnings, fmt.Sprintf(format, args...))
+		}
+		if !version {
+			warn("no STORAGE_MIN_VERSION marker: it may not be a cockroach store")
+		} else if _, err := os.Stat(filepath.Join(d, "STORAGE_MIN_VERSION")); err != nil {
+			warn("only a COCKROACHDB_VERSION marker: it was written by an old cockroach which the current one may not be able to upgrade from")
+		}
+		if _, err := os.Stat(filepath.Join(d, "COCKROACHDB_REGISTRY")); err == nil {
+			warn("it uses encryption at rest, which requires --enterprise-encryption in the node's args")
+		}
+		if storeInUse(d) {
+			warn("it is in use by another process")
+		}
+		if s.NodeID == 0 {
+			warn("no node ID found in its logs: its node ID and ports are assigned by roachdemo")
+		}
+		stores = append(stores, s)
+	}
+	return stores, nil
+}
+
+// assignStoreIDs returns the node ID each store is imported as: its
+// recorded node ID if it has one which no other store claims, and otherwise
+// the next ID after all of the recorded ones.
+func assignStoreIDs(stores []importedStore) []int {
+	ids := make([]int, len(stores))
+	claimed := map[int]string{}
+	next := 1
+	for i := range stores {
+		s := &stores[i]
+		if s.NodeID == 0 {
+			continue
+		}
+		if other, ok := claimed[s.NodeID]; ok {
+			s.Warnings = append(s.Warnings, fmt.Sprintf("node ID %d is also recorded by %s", s.NodeID, other))
+			continue
+		}
+		claimed[s.NodeID] = s.Path
+		ids[i] = s.NodeID
+		if s.NodeID >= next {
+			next = s.NodeID + 1
+		}
+	}
+	for i := range ids {
+		if ids[i] == 0 {
+			ids[i] = next
+			next++
+		}
+	}
+	return ids
+}
+
+// importStores creates and starts a node for each store, in node ID order
+// and in batches as boot does. Each node gets the ports it would have been
+// allocated had the nodes been created by roachdemo, so that nodes which
+// were run by roachdemo find each other where they used to.
+func (c *cluster) importStores(stores []importedStore, concurrency int, stagger time.Duration) error {
+	ids := assignStoreIDs(stores)
+	order := make([]int, len(stores))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return ids[order[a]] < ids[order[b]] })
+
+	maxID := 0
+	for _, id := range ids {
+		if id > maxID {
+			maxID = id
+		}
+	}
+	if err := c.portsAvailable(maxID); err != nil {
+		return err
+	}
+
+	if concurrency <= 0 {
+		concurrency = len(stores)
+	}
+	for n, i := range order {
+		if n > 0 && n%concurrency == 0 && stagger > 0 {
+			time.Sleep(stagger)
+		}
+		s, id := stores[i], ids[i]
+		c.stores[id] = s.Path
+		c.NextPort = basePort + 2*(id-1)
+		if c.NextSQLPort != 0 {
+			c.NextSQLPort = *sqlBasePort + id - 1
+		}
+		t, err := c.createNodeID(id)
+		if err != nil {
+			delete(c.stores, id)
+			return err
+		}
+		// A node which fails to start is discarded along with its store.
+		t.startService()
+		if err := t.startError(); err != nil {
+			c.discardNode(t)
+			return fmt.Errorf("unable to start node %s for store %s: %s", t.Name, s.Path, err)
+		}
+		c.events.add(t.Name, "imported store %s", s.Path)
+		for _, w := range s.Warnings {
+			log.Printf("import: %s: %s", s.Path, w)
+			c.events.add(t.Name, "imported store %s: %s", s.Path, w)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package main
+
+import "testing"
+
+func TestImportStoresFailedStartDiscarded(t *testing.T) {
+	inTempDir(t)
+	defer func(bin string) { cockroachBin = bin }(cockroachBin)
+	cockroachBin = "/nonexistent/cockroach"
+	c := newCluster(nil, nil, nil, make(perNodeAttribute), nil, "localhost", "")
+	err := c.importStores([]importedStore{{Path: "/stores/a", NodeID: 1}}, 1, 0)
+	if err == nil {
+		t.Fatalf("expected the failed start to be reported")
+	}
+	if len(c.Nodes) != 0 || len(c.stores) != 0 {
+		t.Fatalf("expected the node and its store to be discarded, got %d nodes and %v", len(c.Nodes), c.stores)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// initAttemptTimeout bounds each run of "cockroach init", which is
+	// retried rather than waited on for the full -command-timeout.
+	initAttemptTimeout = 10 * time.Second
+	// initMinBackoff and initMaxBackoff bound the wait between attempts,
+	// which doubles after each failure.
+	initMinBackoff = time.Second
+	initMaxBackoff = 10 * time.Second
+)
+
+// initState is the progress of initializing the cluster with -auto-init.
+type initState struct {
+	// Status is "initializing", "initialized" or "failed".
+	Status    string
+	Attempts  int
+	LastError string
+	// Retry is when the next attempt is made while initializing.
+	Retry    time.Time
+	Started  time.Time
+	Finished time.Time
+}
+
+// clusterInit records the progress of initializing the cluster, if it has
+// been attempted.
+type clusterInit struct {
+	mu    sync.Mutex
+	state *initState
+}
+
+// Init returns the progress of initializing the cluster, or nil if
+// -auto-init isn't set.
+func (c *cluster) Init() *initState {
+	c.initProgress.mu.Lock()
+	defer c.initProgress.mu.Unlock()
+	if c.initProgress.state == nil {
+		return nil
+	}
+	s := *c.initProgress.state
+	return &s
+}
+
+// updateInit applies f to the progress of initializing the cluster.
+func (c *cluster) updateInit(f func(s *initState)) {
+	c.initProgress.mu.Lock()
+	defer c.initProgress.mu.Unlock()
+	f(c.initProgress.state)
+}
+
+// initNode runs "cockroach init" against the node. A cluster which has
+// already been initialized isn't an error, so that initializing is
+// idempotent.
+func (n *node) initNode() error {
+	bin := cockroachBin
+	if n.Container == "" {
+		bin = n.Binary()
+	}
+	out, err := runCommand(initAttemptTimeout, nil, bin, "init", "--insecure",
+		"--host="+net.JoinHostPort(n.Host, strconv.Itoa(n.Port)))
+	if err == nil || strings.Contains(string(out), "already been initialized") {
+		return nil
+	}
+	if out = bytes.TrimSpace(out); len(out) > 0 {
+		return fmt.Errorf("%s: %s", err, out)
+	}
+	return err
+}
+
+// autoInit initializes the cluster through the bootstrap node, retrying with
+// backoff for up to timeout as init fails until the node is ready to accept
+// it, e.g. when it races the node's startup.
+func (c *cluster) autoInit(timeout time.Duration) {
+	t, ok := c.Nodes[bootstrapNode]
+	if !ok {
+		return
+	}
+	now := time.Now()
+	c.initProgress.mu.Lock()
+	c.initProgress.state = &initState{Status: "initializing", Started: now}
+	c.initProgress.mu.Unlock()
+
+	deadline := now.Add(timeout)
+	backoff := initMinBackoff
+	for attempt := 1; ; attempt++ {
+		err := t.initNode()
+		now := time.Now()
+		if err == nil {
+			c.updateInit(func(s *initState) {
+				s.Status, s.Attempts, s.Finished = "initialized", attempt, now
+			})
+			c.events.add(t.Name, "cluster initialized after %d attempt(s)", attempt)
+			return
+		}
+		if now.Add(backoff).After(deadline) {
+			c.updateInit(func(s *initState) {
+				s.Status, s.Attempts, s.LastError, s.Finished = "failed", attempt, err.Error(), now
+			})
+			log.Printf("unable to initialize the cluster after %d attempts: %s", attempt, err)
+			c.events.add(t.Name, "init failed after %d attempts: %s", attempt, err)
+			return
+		}
+		c.updateInit(func(s *initState) {
+			s.Attempts, s.LastError, s.Retry = attempt, err.Error(), now.Add(backoff)
+		})
+		c.events.add(t.Name, "init attempt %d failed, retrying in %s: %s", attempt, backoff, err)
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > initMaxBackoff {
+			backoff = initMaxBackoff
+		}
+	}
+}
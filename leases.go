@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// systemRangesQuery selects the ranges of the system tables along with the
+// ranges outside of any table, such as meta and node liveness, and their
+// leaseholders.
+const systemRangesQuery = `SELECT range_id, start_pretty, end_pretty, table_name, lease_holder, replicas
+FROM crdb_internal.ranges
+WHERE database_name IN ('system', '')
+ORDER BY range_id`
+
+// columnIndexes returns the index of each of the named columns in the
+// header row of a query's result.
+func columnIndexes(header []string, names ...string) (map[string]int, error) {
+	cols := map[string]int{}
+	for i, col := range header {
+		cols[col] = i
+	}
+	for _, name := range names {
+		if _, ok := cols[name]; !ok {
+			return nil, fmt.Errorf("unexpected columns %q", header)
+		}
+	}
+	return cols, nil
+}
+
+// refreshNodeIDs records the node ID cockroach assigned each node which has
+// joined the cluster, as gossiped to via. Nodes are matched by the port of
+// the address they advertise, which is unique on the host.
+func (c *cluster) refreshNodeIDs(via *node) error {
+	rows, err := via.sqlRows("SELECT node_id, address FROM crdb_internal.gossip_nodes")
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("no output")
+	}
+	cols, err := columnIndexes(rows[0], "node_id", "address")
+	if err != nil {
+		return err
+	}
+	byPort := map[string]*node{}
+	for _, t := range c.Nodes {
+		byPort[strconv.Itoa(t.Port)] = t
+	}
+	for _, row := range rows[1:] {
+		if len(row) != len(rows[0]) {
+			continue
+		}
+		id, err := strconv.Atoi(row[cols["node_id"]])
+		if err != nil {
+			continue
+		}
+		_, port, err := net.SplitHostPort(row[cols["address"]])
+		if err != nil {
+			continue
+		}
+		if t, ok := byPort[port]; ok {
+			t.CockroachID = id
+		}
+	}
+	return nil
+}
+
+// nodeByCockroachID returns the name of the node cockroach knows by id, or
+// "" if there is none.
+func (c *cluster) nodeByCockroachID(id int) string {
+	for _, t := range c.Nodes {
+		if t.CockroachID == id {
+			return t.Name
+		}
+	}
+	return ""
+}
+
+// systemRange is a system range and the nodes holding its lease and
+// replicas, for leases.html. Nodes are named by roachdemo node name, or by
+// cockroach node ID (e.g. n4) if they aren't known to roachdemo.
+type systemRange struct {
+	ID       string
+	Start    string
+	End      string
+	Table    string
+	Lease    string
+	Replicas []string
+	// LeaseDown is set if the leaseholder is a node which isn't running,
+	// i.e. the lease hasn't moved yet.
+	LeaseDown bool
+}
+
+// leaseCount is the number of system range leases held by a node, along
+// with the node's cockroach node ID if known. Unknown is set for nodes of
+// the cluster which aren't managed by roachdemo.
+type leaseCount struct {
+	Node        string
+	CockroachID int
+	Count       int
+	Unknown     bool
+}
+
+// parseReplicas parses an array of node IDs as output by cockroach sql,
+// e.g. {1,2,3}.
+func parseReplicas(s string) []int {
+	var ids []int
+	for _, f := range strings.Split(strings.Trim(s, "{}"), ",") {
+		if id, err := strconv.Atoi(strings.TrimSpace(f)); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// systemRanges queries the bootstrap node for the leaseholders of the
+// system ranges, mapping cockroach node IDs to node names.
+func (c *cluster) systemRanges() ([]systemRange, error) {
+	t, ok := c.Nodes[bootstrapNode]
+	if !ok || t.Status() != "Running" {
+		return nil, fmt.Errorf("node %s, which is queried for the leases, is not running", bootstrapNode)
+	}
+	if err := c.refreshNodeIDs(t); err != nil {
+		return nil, fmt.Errorf("unable to map node IDs: %s", err)
+	}
+	rows, err := t.sqlRows(systemRangesQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no output")
+	}
+	cols, err := columnIndexes(rows[0], "range_id", "start_pretty", "end_pretty", "table_name", "lease_holder", "replicas")
+	if err != nil {
+		return nil, err
+	}
+	name := func(id int) string {
+		if n := c.nodeByCockroachID(id); n != "" {
+			return n
+		}
+		return fmt.Sprintf("n%d", id)
+	}
+
+	var ranges []systemRange
+	for _, row := range rows[1:] {
+		if len(row) != len(rows[0]) {
+			continue
+		}
+		r := systemRange{
+			ID:    row[cols["range_id"]],
+			Start: row[cols["start_pretty"]],
+			End:   row[cols["end_pretty"]],
+			Table: row[cols["table_name"]],
+		}
+		if id, err := strconv.Atoi(row[cols["lease_holder"]]); err == nil {
+			r.Lease = name(id)
+			if n, ok := c.Nodes[r.Lease]; ok && n.Status() != "Running" {
+				r.LeaseDown = true
+			}
+		}
+		for _, id := range parseReplicas(row[cols["replicas"]]) {
+			r.Replicas = append(r.Replicas, name(id))
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+// showLeases shows which nodes hold the leases of the system ranges. The
+// leases are queried each time the page is loaded.
+func (c *cluster) showLeases(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	data := map[string]interface{}{
+		"Title":   "leases",
+		"Page":    "Leases",
+		"Cluster": c,
+	}
+	ranges, err := c.systemRanges()
+	if err != nil {
+		data["Error"] = err.Error()
+	}
+	counts := map[string]int{}
+	for _, r := range ranges {
+		if r.Lease != "" {
+			counts[r.Lease]++
+		}
+	}
+	var leases []leaseCount
+	for _, t := range c.sortedNodes() {
+		leases = append(leases, leaseCount{Node: t.Name, CockroachID: t.CockroachID, Count: counts[t.Name]})
+		delete(counts, t.Name)
+	}
+	var unknown []string
+	for n := range counts {
+		unknown = append(unknown, n)
+	}
+	sort.Strings(unknown)
+	for _, n := range unknown {
+		leases = append(leases, leaseCount{Node: n, Count: counts[n], Unknown: true})
+	}
+	data["Ranges"] = ranges
+	data["Leases"] = leases
+	renderLayout(rw, req, "leases.html", "layout.html", "Content", data)
+}
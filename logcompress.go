@@ -0,0 +1,178 @@
+package main
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// compressInterval is how often the logs of old runs are checked for
+// compression, unless the age at which they're compressed is shorter.
+const compressInterval = time.Minute
+
+// gzipSuffix is appended to the path of a compressed log file.
+const gzipSuffix = ".gz"
+
+// compressLog gzips the log file at path into path.gz, keeping its
+// modification time, and removes it. The compressed file is renamed into
+// place before the original is removed so that readers always find one of
+// the two.
+func compressLog(path string) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	fi, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(out.Name())
+	gz := gzip.NewWriter(out)
+	if _, err := io.Copy(gz, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	if err := os.Chtimes(out.Name(), fi.ModTime(), fi.ModTime()); err != nil {
+		return err
+	}
+	if err := os.Rename(out.Name(), path+gzipSuffix); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+type gzipReadCloser struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (r gzipReadCloser) Close() error {
+	r.Reader.Close()
+	return r.f.Close()
+}
+
+// openLog opens the log file at path for reading, transparently
+// decompressing path.gz if the file itself has been compressed.
+func openLog(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err == nil || !os.IsNotExist(err) {
+		return f, err
+	}
+	gf, gzErr := os.Open(path + gzipSuffix)
+	if gzErr != nil {
+		// Report the missing log rather than the missing compressed log.
+		return nil, err
+	}
+	gz, err := gzip.NewReader(gf)
+	if err != nil {
+		gf.Close()
+		return nil, err
+	}
+	return gzipReadCloser{Reader: gz, f: gf}, nil
+}
+
+// decompressLog returns an unlinked temporary file holding the decompressed
+// contents of path.gz, for readers which need a file rather than a stream.
+// It returns the error opening path if path.gz doesn't exist either.
+func decompressLog(path string) (*os.File, error) {
+	r, err := openLog(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	f, err := ioutil.TempFile("", "roachdemo-log-")
+	if err != nil {
+		return nil, err
+	}
+	os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// statLog returns the size of the (uncompressed) output of the log file at
+// path and its modification time, falling back to path.gz.
+func statLog(path string) (int64, time.Time, error) {
+	fi, err := os.Stat(path)
+	if err == nil || !os.IsNotExist(err) {
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		return fi.Size(), fi.ModTime(), nil
+	}
+	f, gzErr := os.Open(path + gzipSuffix)
+	if gzErr != nil {
+		return 0, time.Time{}, err
+	}
+	defer f.Close()
+	if fi, err = f.Stat(); err != nil {
+		return 0, time.Time{}, err
+	}
+	// The gzip trailer ends with the size of the uncompressed data, modulo
+	// 2^32.
+	var size [4]byte
+	if fi.Size() < int64(len(size)) {
+		return 0, fi.ModTime(), nil
+	}
+	if _, err := f.ReadAt(size[:], fi.Size()-int64(len(size))); err != nil {
+		return 0, time.Time{}, err
+	}
+	return int64(binary.LittleEndian.Uint32(size[:])), fi.ModTime(), nil
+}
+
+// compressOldLogs gzips the stdout and stderr logs of the runs which stopped
+// more than age ago, except the pinned runs, whose logs are kept at hand as
+// the nodes' baselines.
+func (c *cluster) compressOldLogs(age time.Duration) {
+	for _, t := range append(c.sortedNodes(), c.sortedTenants()...) {
+		active := t.Active()
+		for _, r := range t.Runs {
+			if r == active || r.Pinned || r.Stopped.IsZero() || time.Since(r.Stopped) < age {
+				continue
+			}
+			for _, path := range []string{r.Stdout, r.Stderr} {
+				if path == "" {
+					continue
+				}
+				if _, err := os.Stat(path); err != nil {
+					continue
+				}
+				if err := compressLog(path); err != nil {
+					log.Printf("node %s: unable to compress %s: %s", t.Name, path, err)
+				}
+			}
+		}
+	}
+}
+
+// logCompressor periodically compresses the logs of runs which stopped more
+// than age ago.
+func (c *cluster) logCompressor(age time.Duration) {
+	interval := compressInterval
+	if age < interval {
+		interval = age
+	}
+	for range time.Tick(interval) {
+		c.compressOldLogs(age)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCompressOldLogsSkipsPinned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-compress")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	n := newNode("1", nil, nil, false, "", "", "", "")
+	c.Nodes[n.Name] = n
+	stopped := time.Now().Add(-time.Hour)
+	for i := 0; i < 2; i++ {
+		path := filepath.Join(dir, strconv.Itoa(i)+".stdout")
+		if err := ioutil.WriteFile(path, []byte("output\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		n.Runs = append(n.Runs, &nodeRun{ID: i, Stopped: stopped, Stdout: path})
+	}
+	n.pin(n.Runs[1])
+
+	c.compressOldLogs(time.Minute)
+	if _, err := os.Stat(n.Runs[0].Stdout + gzipSuffix); err != nil {
+		t.Errorf("expected the log of the unpinned run to be compressed: %s", err)
+	}
+	if _, err := os.Stat(n.Runs[1].Stdout); err != nil {
+		t.Errorf("expected the log of the pinned run to be kept: %s", err)
+	}
+}
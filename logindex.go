@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// logIndexInterval is the number of bytes of output between the entries
+	// of a log's timestamp index.
+	logIndexInterval = 64 << 10
+	// logSeekWindow bounds the output shown after seeking to a time.
+	logSeekWindow = 1 << 20
+	// logTimestampLen is the length of the header of a cockroach log line
+	// up to the end of its timestamp, e.g. "I210101 12:00:00.123456".
+	logTimestampLen = len("I060102 15:04:05.000000")
+)
+
+// parseLogTimestamp returns the time of a cockroach log line, which starts
+// with its severity followed by the time in UTC.
+func parseLogTimestamp(line []byte) (time.Time, bool) {
+	if len(line) < logTimestampLen || bytes.IndexByte([]byte("IWEF"), line[0]) < 0 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("060102 15:04:05.000000", string(line[1:logTimestampLen]))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// parseSeekTime parses the "at" form value of the log views: an RFC 3339
+// time, or a date and time without a zone, which is taken to be UTC like the
+// timestamps of cockroach's logs.
+func parseSeekTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02T15:04"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: expected e.g. 2021-01-01T12:00:00", s)
+}
+
+type logIndexEntry struct {
+	time   time.Time
+	offset int64
+}
+
+// logIndex is a sparse index of the timestamps of a log, mapping the time
+// of a line roughly every logIndexInterval bytes to its offset. It is fed
+// the log's output as it is written, or the log file's contents when built
+// after the fact.
+type logIndex struct {
+	mu      sync.Mutex
+	entries []logIndexEntry
+	// offset is the number of bytes fed, lineStart the offset of the current
+	// line and head its first bytes, up to logTimestampLen.
+	offset    int64
+	lineStart int64
+	head      []byte
+	nextEntry int64
+}
+
+func (x *logIndex) feed(p []byte) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for len(p) > 0 {
+		line := p
+		eol := bytes.IndexByte(p, '\n')
+		if eol >= 0 {
+			line = p[:eol]
+		}
+		if n := logTimestampLen - len(x.head); n > 0 {
+			if n > len(line) {
+				n = len(line)
+			}
+			x.head = append(x.head, line[:n]...)
+		}
+		if eol < 0 {
+			x.offset += int64(len(p))
+			return
+		}
+		x.offset += int64(eol + 1)
+		if x.lineStart >= x.nextEntry {
+			if t, ok := parseLogTimestamp(x.head); ok {
+				x.entries = append(x.entries, logIndexEntry{time: t, offset: x.lineStart})
+				x.nextEntry = x.lineStart + logIndexInterval
+			}
+		}
+		x.lineStart, x.head = x.offset, x.head[:0]
+		p = p[eol+1:]
+	}
+}
+
+// seek returns the offset of the last indexed line at or before t, or 0 if
+// there is none.
+func (x *logIndex) seek(t time.Time) int64 {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	i := sort.Search(len(x.entries), func(i int) bool { return x.entries[i].time.After(t) })
+	if i == 0 {
+		return 0
+	}
+	return x.entries[i-1].offset
+}
+
+// buildLogIndex indexes the log file at path, or path.gz if it has been
+// compressed.
+func buildLogIndex(path string) (*logIndex, error) {
+	f, err := openLog(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	x := &logIndex{}
+	buf := make([]byte, 32<<10)
+	for {
+		n, err := f.Read(buf)
+		x.feed(buf[:n])
+		if err == io.EOF {
+			return x, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// indexLogWriter is a logWriter which feeds the output written to it to a
+// logIndex, in addition to writing it to the wrapped writer.
+type indexLogWriter struct {
+	logWriter
+	index *logIndex
+}
+
+func (w indexLogWriter) Write(p []byte) (int, error) {
+	n, err := w.logWriter.Write(p)
+	w.index.feed(p[:n])
+	return n, err
+}
+
+// readFrom returns up to max bytes of the log starting at offset, seeking
+// in the file or, if it has been compressed, skipping to the offset.
+func (w fileLogWriter) readFrom(offset, max int64) ([]byte, error) {
+	f, err := os.Open(w.filename)
+	if err == nil {
+		defer f.Close()
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return ioutil.ReadAll(io.LimitReader(f, max))
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	r, err := openLog(w.filename)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	if _, err := io.CopyN(ioutil.Discard, r, offset); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return ioutil.ReadAll(io.LimitReader(r, max))
+}
+
+// logSeek is the output of a log from around a time, for log.html.
+type logSeek struct {
+	At     time.Time
+	Offset int64
+	// More is set if the output continues beyond what is shown.
+	More bool
+}
+
+// seekLog returns the output of the run's stdout or stderr log starting
+// from the first line at or after at, using the log's timestamp index to
+// skip to a line shortly before it. The index is built from the log file if
+// the run has none.
+func (r *nodeRun) seekLog(stream string, at time.Time) (string, *logSeek, error) {
+	path, index := r.Stdout, &r.stdoutIndex
+	if stream == "stderr" {
+		path, index = r.Stderr, &r.stderrIndex
+	}
+	if path == "" {
+		return "", nil, fmt.Errorf("%s is not captured", stream)
+	}
+	r.indexMu.Lock()
+	if *index == nil {
+		x, err := buildLogIndex(path)
+		if err != nil {
+			r.indexMu.Unlock()
+			return "", nil, err
+		}
+		*index = x
+	}
+	x := *index
+	r.indexMu.Unlock()
+
+	offset := x.seek(at)
+	b, err := fileLogWriter{filename: path}.readFrom(offset, logSeekWindow+1)
+	if err != nil {
+		return "", nil, err
+	}
+	s := &logSeek{At: at, Offset: offset}
+	if len(b) > logSeekWindow {
+		b, s.More = b[:logSeekWindow], true
+	}
+	// Skip the indexed lines before at.
+	for i := 0; i < len(b); {
+		eol := bytes.IndexByte(b[i:], '\n')
+		if t, ok := parseLogTimestamp(b[i:]); ok && !t.Before(at) {
+			b, s.Offset = b[i:], offset+int64(i)
+			break
+		}
+		if eol < 0 {
+			break
+		}
+		i += eol + 1
+	}
+	return string(b), s, nil
+}
+
+// runLogOutput returns the output of the run's stdout or stderr log for
+// log.html: all of it, or the output from the time given by the "at" form
+// value on if set. It returns false after reporting an error.
+func runLogOutput(rw http.ResponseWriter, req *http.Request, r *nodeRun, stream string) (string, *logSeek, bool) {
+	at := req.FormValue("at")
+	if at == "" {
+		if stream == "stderr" {
+			return r.StderrString(), nil, true
+		}
+		return r.StdoutString(), nil, true
+	}
+	t, err := parseSeekTime(at)
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, err.Error())
+		return "", nil, false
+	}
+	output, seek, err := r.seekLog(stream, t)
+	if err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, fmt.Sprintf("unable to seek in %s: %s", stream, err))
+		return "", nil, false
+	}
+	return output, seek, true
+}
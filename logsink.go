@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"log/syslog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// sinkBufferLines is the number of lines a sink holds while waiting to
+	// send them. Lines written while the buffer is full are dropped rather
+	// than blocking the node.
+	sinkBufferLines = 10000
+	// sinkBatchLines is the maximum number of lines sent at once.
+	sinkBatchLines = 500
+	// sinkFlushInterval is how often buffered lines are sent.
+	sinkFlushInterval = time.Second
+	// sinkTimeout bounds each send to the remote endpoint.
+	sinkTimeout = 10 * time.Second
+)
+
+// logSinks are the remote endpoints node output is forwarded to, configured
+// by -syslog-addr and -loki-url.
+var logSinks []*logSink
+
+type logEntry struct {
+	time   time.Time
+	node   string
+	run    int
+	stream string
+	line   string
+}
+
+// logSink forwards lines of node output to a remote endpoint in batches from
+// a background goroutine. send returns the number of entries sent before any
+// error; the rest are retried with the next batch, up to sinkBufferLines.
+type logSink struct {
+	name    string
+	entries chan logEntry
+	send    func([]logEntry) (int, error)
+}
+
+func newLogSink(name string, send func([]logEntry) (int, error)) *logSink {
+	s := &logSink{
+		name:    name,
+		entries: make(chan logEntry, sinkBufferLines),
+		send:    send,
+	}
+	go s.loop()
+	return s
+}
+
+// add queues the entry without blocking, dropping it if the buffer is full.
+func (s *logSink) add(e logEntry) {
+	select {
+	case s.entries <- e:
+	default:
+		statSinkDropped.Add(1)
+	}
+}
+
+func (s *logSink) loop() {
+	var pending []logEntry
+	failing := false
+	for range time.Tick(sinkFlushInterval) {
+	drain:
+		for len(pending) < sinkBufferLines {
+			select {
+			case e := <-s.entries:
+				pending = append(pending, e)
+			default:
+				break drain
+			}
+		}
+		for len(pending) > 0 {
+			n := len(pending)
+			if n > sinkBatchLines {
+				n = sinkBatchLines
+			}
+			sent, err := s.send(pending[:n])
+			pending = pending[sent:]
+			if err != nil {
+				// Only log the transition to failing, rather than every
+				// failed attempt.
+				if !failing {
+					log.Printf("%s: unable to send node output, retrying: %s", s.name, err)
+					failing = true
+				}
+				break
+			}
+			if failing {
+				log.Printf("%s: sending node output again", s.name)
+				failing = false
+			}
+		}
+		if len(pending) == 0 {
+			pending = nil
+		}
+	}
+}
+
+// newSyslogSink returns a sink sending each line as a syslog message to
+// addr, given as host:port (UDP) or as udp://host:port or tcp://host:port.
+// Lines from stderr are sent with priority warning and lines from stdout
+// with priority info.
+func newSyslogSink(addr string) (*logSink, error) {
+	network := "udp"
+	if i := strings.Index(addr, "://"); i >= 0 {
+		network, addr = addr[:i], addr[i+3:]
+	}
+	if network != "udp" && network != "tcp" {
+		return nil, fmt.Errorf("unsupported network %q", network)
+	}
+
+	var w *syslog.Writer
+	return newLogSink("syslog", func(entries []logEntry) (int, error) {
+		if w == nil {
+			var err error
+			w, err = syslog.Dial(network, addr, syslog.LOG_INFO|syslog.LOG_DAEMON, "roachdemo")
+			if err != nil {
+				return 0, err
+			}
+		}
+		for i, e := range entries {
+			msg := fmt.Sprintf("node=%s run=%d: %s", e.node, e.run, e.line)
+			var err error
+			if e.stream == "stderr" {
+				err = w.Warning(msg)
+			} else {
+				err = w.Info(msg)
+			}
+			if err != nil {
+				// Redial on the next attempt.
+				w.Close()
+				w = nil
+				return i, err
+			}
+		}
+		return len(entries), nil
+	}), nil
+}
+
+// newLokiSink returns a sink pushing lines to the Loki push API at pushURL,
+// e.g. http://localhost:3100/loki/api/v1/push, labelled with the node, run
+// and stream.
+func newLokiSink(pushURL string) (*logSink, error) {
+	u, err := url.Parse(pushURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URL %q", pushURL)
+	}
+
+	client := &http.Client{Timeout: sinkTimeout}
+	return newLogSink("loki", func(entries []logEntry) (int, error) {
+		type stream struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		}
+		var streams []*stream
+		byLabels := map[string]*stream{}
+		for _, e := range entries {
+			key := fmt.Sprintf("%s/%d/%s", e.node, e.run, e.stream)
+			s, ok := byLabels[key]
+			if !ok {
+				s = &stream{Stream: map[string]string{
+					"job":    "roachdemo",
+					"node":   e.node,
+					"run":    strconv.Itoa(e.run),
+					"stream": e.stream,
+				}}
+				byLabels[key] = s
+				streams = append(streams, s)
+			}
+			s.Values = append(s.Values, [2]string{strconv.FormatInt(e.time.UnixNano(), 10), e.line})
+		}
+		body, err := json.Marshal(map[string]interface{}{"streams": streams})
+		if err != nil {
+			return 0, err
+		}
+		resp, err := client.Post(pushURL, "application/json", bytes.NewReader(body))
+		if err != nil {
+			return 0, err
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return 0, fmt.Errorf("%s", resp.Status)
+		}
+		return len(entries), nil
+	}), nil
+}
+
+// teeLogWriter is a logWriter which forwards each complete line written to
+// it to the log sinks, in addition to writing it to the wrapped writer.
+type teeLogWriter struct {
+	logWriter
+	node    string
+	run     int
+	stream  string
+	partial []byte
+}
+
+// teeLogs wraps w so its output is also forwarded to the log sinks, if any
+// are configured.
+func teeLogs(w logWriter, node string, run int, stream string) logWriter {
+	if len(logSinks) == 0 {
+		return w
+	}
+	return &teeLogWriter{logWriter: w, node: node, run: run, stream: stream}
+}
+
+func (w *teeLogWriter) Write(p []byte) (int, error) {
+	n, err := w.logWriter.Write(p)
+	now := time.Now()
+	w.partial = append(w.partial, p[:n]...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		w.forward(now, string(w.partial[:i]))
+		w.partial = w.partial[i+1:]
+	}
+	if len(w.partial) == 0 {
+		w.partial = nil
+	}
+	return n, err
+}
+
+// Close forwards any final unterminated line and closes the wrapped writer.
+func (w *teeLogWriter) Close() {
+	if len(w.partial) > 0 {
+		w.forward(time.Now(), string(w.partial))
+		w.partial = nil
+	}
+	w.logWriter.Close()
+}
+
+func (w *teeLogWriter) forward(t time.Time, line string) {
+	e := logEntry{time: t, node: w.node, run: w.run, stream: w.stream, line: line}
+	for _, s := range logSinks {
+		s.add(e)
+	}
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// logTailInterval is how often a tailed log file is checked for new output.
+const logTailInterval = 250 * time.Millisecond
+
+// logTailMaxChunk is the maximum amount of output sent in a single message. A
+// client which falls further behind than this has the older output skipped.
+const logTailMaxChunk = 64 << 10
+
+// logTailControl is a control message sent by a log tail client. Cmd may be
+// "pause" or "resume". A non-nil Grep sets the server side filter, with an
+// empty pattern clearing it.
+type logTailControl struct {
+	Cmd  string  `json:"cmd"`
+	Grep *string `json:"grep"`
+}
+
+// countLines returns the number of newlines in the n bytes of r starting at
+// off.
+func countLines(r io.ReaderAt, off, n int64) int {
+	var count int
+	buf := make([]byte, 32<<10)
+	sr := io.NewSectionReader(r, off, n)
+	for {
+		m, err := sr.Read(buf)
+		count += bytes.Count(buf[:m], []byte{'\n'})
+		if err != nil {
+			return count
+		}
+	}
+}
+
+// logTail reads the output appended to a log file.
+type logTail struct {
+	f       *os.File
+	offset  int64
+	partial []byte
+}
+
+// openLogTail opens the log file at path, or a decompressed copy of path.gz
+// if the file has been compressed.
+func openLogTail(path string) (*logTail, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		f, err = decompressLog(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &logTail{f: f}, nil
+}
+
+func (t *logTail) close() {
+	t.f.Close()
+}
+
+// read returns the complete lines written since the last call, or all of the
+// remaining output if final is set because the file is no longer written
+// to. If more than logTailMaxChunk is pending, the older output is skipped
+// and the number of skipped lines returned. atEnd indicates that all of the
+// file's output has been read.
+func (t *logTail) read(final bool) (out []byte, skipped int, atEnd bool, err error) {
+	fi, err := t.f.Stat()
+	if err != nil {
+		return nil, 0, false, err
+	}
+	size := fi.Size()
+	if size-t.offset > logTailMaxChunk {
+		skip := size - t.offset - logTailMaxChunk
+		skipped = countLines(t.f, t.offset, skip)
+		t.offset += skip
+		t.partial = nil
+	}
+	if size > t.offset {
+		buf := make([]byte, size-t.offset)
+		n, _ := t.f.ReadAt(buf, t.offset)
+		t.offset += int64(n)
+		t.partial = append(t.partial, buf[:n]...)
+	}
+
+	// Only complete lines are returned until the output is final.
+	if final {
+		out, t.partial = t.partial, nil
+	} else if i := bytes.LastIndexByte(t.partial, '\n'); i >= 0 {
+		out = t.partial[:i+1]
+		t.partial = append([]byte(nil), t.partial[i+1:]...)
+	}
+	return out, skipped, t.offset >= size, nil
+}
+
+// nodeRunFollow renders a page following the run's output as it is written,
+// like tail -f, using the WebSocket log tail.
+func (c *cluster) nodeRunFollow(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	run := c.findNodeRun(rw, t, args)
+	if run == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":   "Node run " + args["type"],
+		"Page":    "NodeOutput",
+		"Type":    args["type"],
+		"Cluster": c,
+		"Node":    t,
+		"NodeRun": run,
+	}
+
+	renderLayout(rw, req, "follow.html", "layout.html", "Content", data)
+}
+
+func (c *cluster) nodeRunWSLog(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	run := c.findNodeRun(rw, t, args)
+	if run == nil {
+		return
+	}
+
+	path := run.Stdout
+	if args["type"] == "stderr" && !run.Merged {
+		path = run.Stderr
+	}
+	if path == "" {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("%s of node %s run %d is not captured", args["type"], t.Name, run.ID))
+		return
+	}
+
+	ws, err := wsUpgrade(rw, req)
+	if err != nil {
+		return
+	}
+	defer ws.close()
+	statSubscriptions.Add(1)
+	defer statSubscriptions.Add(-1)
+
+	var mu sync.Mutex
+	var paused bool
+	var grep *regexp.Regexp
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			msg, err := ws.readMessage()
+			if err != nil {
+				return
+			}
+			var ctl logTailControl
+			if err := json.Unmarshal([]byte(msg), &ctl); err != nil {
+				_ = ws.writeText(fmt.Sprintf("invalid control message: %s\n", err))
+				continue
+			}
+			mu.Lock()
+			switch ctl.Cmd {
+			case "pause":
+				paused = true
+			case "resume":
+				paused = false
+			}
+			if ctl.Grep != nil {
+				if *ctl.Grep == "" {
+					grep = nil
+				} else if re, err := regexp.Compile(*ctl.Grep); err != nil {
+					_ = ws.writeText(fmt.Sprintf("invalid pattern: %s\n", err))
+				} else {
+					grep = re
+				}
+			}
+			mu.Unlock()
+		}
+	}()
+
+	tail, err := openLogTail(path)
+	if err != nil {
+		_ = ws.writeText(err.Error() + "\n")
+		return
+	}
+	defer tail.close()
+
+	ticker := time.NewTicker(logTailInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		mu.Lock()
+		p, re := paused, grep
+		mu.Unlock()
+		if p {
+			continue
+		}
+
+		// NB: the run is stopped only after its output has been closed, so
+		// once it is stopped the file contains all of the output.
+		stopped := !run.Stopped.IsZero()
+		out, skipped, atEnd, err := tail.read(stopped)
+		if err != nil {
+			_ = ws.writeText(err.Error() + "\n")
+			return
+		}
+		if skipped > 0 {
+			if err := ws.writeText(fmt.Sprintf("... %d lines skipped ...\n", skipped)); err != nil {
+				return
+			}
+		}
+		if re != nil {
+			var filtered bytes.Buffer
+			for _, line := range bytes.SplitAfter(out, []byte{'\n'}) {
+				if len(line) > 0 && re.Match(line) {
+					filtered.Write(line)
+				}
+			}
+			out = filtered.Bytes()
+		}
+		if len(out) > 0 {
+			if err := ws.writeText(string(bytes.ToValidUTF8(out, []byte("�")))); err != nil {
+				return
+			}
+		}
+		if stopped && atEnd {
+			return
+		}
+	}
+}
+
+// logLine is a line of a run's output as streamed by nodeRunTailNDJSON. Seq
+// numbers the lines of each stream from 0, so a jump in Seq indicates lines
+// which were skipped.
+type logLine struct {
+	TS     time.Time `json:"ts"`
+	Node   string    `json:"node"`
+	Run    int       `json:"run"`
+	Stream string    `json:"stream"`
+	Seq    int       `json:"seq"`
+	Line   string    `json:"line"`
+}
+
+// nodeRunTailNDJSON streams the run's output as newline-delimited JSON, one
+// logLine per line, starting with the last logTailMaxChunk of each stream.
+// The stream parameter restricts the output to stdout or stderr. Without
+// follow=true the response ends once the existing output has been sent;
+// otherwise it ends when the run has stopped and all of its output has been
+// sent, or when the client goes away.
+func (c *cluster) nodeRunTailNDJSON(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	run := c.findNodeRun(rw, t, args)
+	if run == nil {
+		return
+	}
+
+	type stream struct {
+		name string
+		tail *logTail
+		seq  int
+	}
+	var streams []*stream
+	for _, s := range []struct{ name, path string }{{"stdout", run.Stdout}, {"stderr", run.Stderr}} {
+		if s.path == "" || (s.name == "stderr" && run.Merged) {
+			continue
+		}
+		if name := req.FormValue("stream"); name != "" && name != s.name {
+			continue
+		}
+		tail, err := openLogTail(s.path)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer tail.close()
+		streams = append(streams, &stream{name: s.name, tail: tail})
+	}
+	if len(streams) == 0 {
+		http.Error(rw, fmt.Sprintf("no captured output of node %s run %d to tail", t.Name, run.ID), http.StatusBadRequest)
+		return
+	}
+
+	follow := req.FormValue("follow") == "true"
+	flusher, _ := rw.(http.Flusher)
+	rw.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(rw)
+
+	ticker := time.NewTicker(logTailInterval)
+	defer ticker.Stop()
+	for {
+		// NB: the run is stopped only after its output has been closed, so
+		// once it is stopped the files contain all of the output.
+		stopped := !run.Stopped.IsZero()
+		final := stopped || !follow
+		done := true
+		for _, s := range streams {
+			out, skipped, atEnd, err := s.tail.read(final)
+			if err != nil {
+				log.Print(err)
+				return
+			}
+			done = done && atEnd
+			s.seq += skipped
+			now := time.Now()
+			for _, line := range bytes.SplitAfter(out, []byte{'\n'}) {
+				if len(line) == 0 {
+					continue
+				}
+				l := logLine{
+					TS:     now,
+					Node:   t.Name,
+					Run:    run.ID,
+					Stream: s.name,
+					Seq:    s.seq,
+					Line:   string(bytes.ToValidUTF8(bytes.TrimSuffix(line, []byte{'\n'}), []byte("�"))),
+				}
+				s.seq++
+				if err := enc.Encode(l); err != nil {
+					return
+				}
+			}
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if final && done {
+			return
+		}
+
+		select {
+		case <-req.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os/exec"
+	"runtime"
+	"sync"
+)
+
+var loopbackWarning sync.Once
+
+// loopbackAlias returns the loopback address of the node with the specified
+// ID in -loopback-aliases mode, 127.0.0.<id+1>, configuring the alias if
+// necessary. 127.0.0.1 is left to roachdemo and other local processes. On Linux the whole of 127.0.0.0/8 is routed to the loopback
+// interface already. On macOS only 127.0.0.1 is, and adding an alias with
+// "ifconfig lo0 alias" requires root, so roachdemo must either run as root
+// or have the aliases added beforehand. If the address is unusable false is
+// returned and the node shares the regular host.
+func loopbackAlias(id int) (string, bool) {
+	if id < 1 || id > 253 {
+		return "", false
+	}
+	addr := fmt.Sprintf("127.0.0.%d", id+1)
+	err := checkLoopback(addr)
+	if err != nil && runtime.GOOS == "darwin" {
+		if out, aerr := exec.Command("ifconfig", "lo0", "alias", addr, "up").CombinedOutput(); aerr != nil {
+			err = fmt.Errorf("%s; ifconfig lo0 alias %s: %s: %s", err, addr, aerr, out)
+		} else {
+			err = checkLoopback(addr)
+		}
+	}
+	if err != nil {
+		loopbackWarning.Do(func() {
+			log.Printf("loopback aliases unavailable, nodes will share the regular host: %s", err)
+		})
+		return "", false
+	}
+	return addr, true
+}
+
+// checkLoopback verifies that addr can be listened on.
+func checkLoopback(addr string) error {
+	l, err := net.Listen("tcp", net.JoinHostPort(addr, "0"))
+	if err != nil {
+		return err
+	}
+	return l.Close()
+}
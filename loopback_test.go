@@ -0,0 +1,27 @@
+package main
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestLoopbackAlias(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("127.0.0.0/8 is only routed to the loopback interface on Linux")
+	}
+	for _, tc := range []struct {
+		id   int
+		addr string
+		ok   bool
+	}{
+		{0, "", false},
+		{1, "127.0.0.2", true},
+		{3, "127.0.0.4", true},
+		{253, "127.0.0.254", true},
+		{254, "", false},
+	} {
+		if addr, ok := loopbackAlias(tc.id); addr != tc.addr || ok != tc.ok {
+			t.Errorf("node %d: expected %q, %t, got %q, %t", tc.id, tc.addr, tc.ok, addr, ok)
+		}
+	}
+}
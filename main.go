@@ -2,23 +2,92 @@ package main
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"html/template"
+	"io"
+	"io/ioutil"
 	"log"
+	"mime"
+	"net"
 	"net/http"
+	"os"
+	"os/exec"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var numNodes = flag.Int("n", 0, "number of nodes")
+var sqlBasePort = flag.Int("sql-port", 0, "first port of the range nodes' SQL ports are allocated from, serving SQL separately from RPC with --sql-addr (0 to serve SQL on the RPC port)")
+var maxPort = flag.Int("max-port", 0, "highest port allocated to nodes; adding nodes beyond it is refused (0 for no limit)")
+var nodeHost = flag.String("node-host", "localhost", "host nodes listen on, e.g. 0.0.0.0 to be reachable from other machines")
+var httpHost = flag.String("http-host", "", "host the nodes' admin UIs listen on (defaults to -node-host)")
+var loopbackAliases = flag.Bool("loopback-aliases", false, "give node i its own loopback address 127.0.0.(i+1) (on macOS this requires root to add the lo0 aliases)")
+var allowTracing = flag.Bool("allow-tracing", false, "allow restarting nodes under strace or ltrace (Linux only)")
+var allowFaults = flag.Bool("allow-faults", false, "allow injecting network faults, e.g. blocking a node's traffic to a peer with iptables (Linux only, requires root and cgroup v2)")
+var allowQuit = flag.Bool("allow-quit", false, "allow POST /quit to stop all nodes and exit roachdemo")
+var readyCmd = flag.String("ready-cmd", "", "shell command used to check whether a node is ready, e.g. \"cockroach sql --insecure --port=$SQL_PORT -e 'SELECT 1'\"")
+var preStartHook = flag.String("pre-start-hook", "", "shell command run before each run of a node is started; a failure aborts the start")
+var postStopHook = flag.String("post-stop-hook", "", "shell command run after each run of a node exits")
+var autoInit = flag.Bool("auto-init", false, "run \"cockroach init\" against node 1 after booting, retrying until it is accepted")
+var initTimeout = flag.Duration("init-timeout", 2*time.Minute, "how long -auto-init retries \"cockroach init\" before giving up")
+var commandTimeout = flag.Duration("command-timeout", 30*time.Second, "how long one-shot cockroach commands, e.g. \"cockroach sql\", may run before they're killed")
+var recordFile = flag.String("record", "", "record the actions taken on the cluster (add, start, stop, pause, ...) to this file, to be replayed with /replay")
+var reconcileInterval = flag.Duration("reconcile-interval", 30*time.Second, "how often nodes which should be running but aren't are started (0 to disable)")
+var syslogAddr = flag.String("syslog-addr", "", "also forward node output to the syslog server at this address, e.g. localhost:514 or tcp://host:514")
+var lokiURL = flag.String("loki-url", "", "also push node output to this Loki push endpoint, e.g. http://localhost:3100/loki/api/v1/push")
+var hostStatsFlag = flag.Bool("host-stats", false, "show the host's cpu, memory and free disk usage on the dashboard")
+var compressOldLogs = flag.Duration("compress-old-logs", 0, "gzip the stdout/stderr logs of runs stopped longer than this ago (0 to never compress)")
+var bufferRetention = flag.Duration("buffer-retention", 0, "drop the output buffers of runs stopped longer than this ago, reading their logs from disk instead (0 to keep them)")
+var sqlPasswordFile = flag.String("sql-password-file", "", "file containing the root password used for SQL run against the nodes")
+var importDir = flag.String("import", "", "adopt the existing cockroach stores in this directory (or its subdirectories) as nodes instead of the nodes in "+dataDir)
+var argsFile = flag.String("args-file", "", "file of additional cockroach args, one per line (# starts a comment)")
+var raceBinary = flag.String("race-binary", "", "race-enabled cockroach binary (built with go build -race) to run the nodes with instead of cockroach; its runs get longer timeouts and their data races are reported")
+var dockerImage = flag.String("docker", "", "run each node in a container of the specified cockroach docker image")
+var bootConcurrency = flag.Int("boot-concurrency", 0, "number of nodes started at once during initial boot (0 for all)")
+var bootStagger = flag.Duration("boot-stagger", 0, "delay between batches of nodes started during initial boot")
+var tlsCert = flag.String("tls-cert", "", "certificate file for serving roachdemo's own UI over HTTPS, with -tls-key; unrelated to the nodes' secure mode")
+var tlsKey = flag.String("tls-key", "", "private key file of -tls-cert")
+var assetsDir = flag.String("assets-dir", "", "directory of templates/ and css/ overriding the embedded assets")
+var basePath = flag.String("base-path", "", "path prefix the UI is served under, e.g. /roachdemo when reverse proxied")
+var demoName = flag.String("name", "", "name telling this roachdemo apart in page titles, the header and the APIs; unrelated to cockroach's --cluster-name (defaults to the hostname and port)")
+var titlePrefix = flag.String("title-prefix", "", "prefix of the pages' titles in place of the -name, e.g. \"staging: \"")
+var favicon = flag.String("favicon", "", "file served as /favicon.ico instead of the built-in icon")
+var devMode = flag.Bool("dev", false, "re-parse templates on each request")
+var replicationFactor = flag.Int("replication-factor", 3, "replication factor of the cluster, used to estimate quorum")
+var watch = flag.Bool("watch", false, "watch -args-file and apply changes to the nodes' args")
+var watchRestart = flag.Bool("watch-restart", false, "with -watch, perform a rolling restart after applying changes")
+var demoMode = flag.Bool("demo", false, "run \"cockroach demo\" instead of managing nodes")
+var mergeOutput = flag.Bool("merge-output", false, "capture stdout and stderr in a single stream")
 var attrs = make(perNodeAttribute)
 var localities = make(perNodeAttribute)
+var stores = make(perNodeAttribute)
+var maxOffsets = make(perNodeAttribute)
+var maxOffset = flag.String("max-offset", "", "--max-offset of the nodes, e.g. 250ms (defaults to cockroach's 500ms)")
 
 var tmpls = map[string]*template.Template{}
 
+// listenAddr is the address roachdemo serves its UI on.
+const listenAddr = "localhost:9999"
+
+// defaultName returns the name of this roachdemo if -name isn't set: the
+// hostname and the port it serves on.
+func defaultName() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+	_, port, _ := net.SplitHostPort(listenAddr)
+	return net.JoinHostPort(host, port)
+}
+
 // stringString conforms to the flag.Value interface
 type perNodeAttribute map[int]string
 
@@ -50,14 +119,102 @@ func (p *perNodeAttribute) Set(value string) error {
 	return nil
 }
 
-func render(asset string, data map[string]interface{}) (string, error) {
+// readArgsFile reads cockroach args from the specified file (see parseArgs).
+func readArgsFile(path string) ([]string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseArgs(string(b)), nil
+}
+
+// parseArgs parses cockroach args given one per line. Each non-blank line is
+// a single argument and lines starting with # are ignored.
+func parseArgs(s string) []string {
+	var args []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		args = append(args, line)
+	}
+	return args
+}
+
+// loadAsset returns the named asset, preferring a copy in the -assets-dir
+// directory over the embedded one. Names are cleaned so that they can't
+// refer to files outside of the directory.
+func loadAsset(name string) ([]byte, error) {
+	rel := strings.TrimPrefix(path.Clean("/"+name), "/")
+	if !strings.HasPrefix(rel, "assets/") {
+		return nil, fmt.Errorf("invalid asset %q", name)
+	}
+	name = rel
+	if *assetsDir != "" {
+		dir, err := filepath.Abs(*assetsDir)
+		if err != nil {
+			return nil, err
+		}
+		file := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(name, "assets/")))
+		if !strings.HasPrefix(file, dir+string(filepath.Separator)) {
+			return nil, fmt.Errorf("invalid asset %q", name)
+		}
+		if b, err := ioutil.ReadFile(file); err == nil {
+			return b, nil
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return Asset(name)
+}
+
+func parseTemplate(path string) (*template.Template, error) {
+	asset, err := loadAsset(path)
+	if err != nil {
+		return nil, err
+	}
+	funcs := template.FuncMap{
+		// base returns the -base-path prefix of absolute links.
+		"base": func() string { return *basePath },
+	}
+	return template.New(path).Funcs(funcs).Parse(string(asset))
+}
+
+// parseTemplates parses the HTML templates among the assets into tmpls.
+func parseTemplates() error {
+	for _, path := range AssetNames() {
+		if !strings.HasSuffix(path, ".html") {
+			continue
+		}
+		t, err := parseTemplate(path)
+		if err != nil {
+			return err
+		}
+		tmpls[filepath.Base(path)] = t
+	}
+	return nil
+}
+
+func lookupTemplate(asset string) (*template.Template, error) {
+	if *devMode {
+		return parseTemplate("assets/templates/" + asset)
+	}
 	t, ok := tmpls[asset]
 	if !ok {
-		return "", fmt.Errorf("%s not found", asset)
+		return nil, fmt.Errorf("%s not found", asset)
+	}
+	return t, nil
+}
+
+func render(asset string, data map[string]interface{}) (string, error) {
+	t, err := lookupTemplate(asset)
+	if err != nil {
+		return "", err
 	}
 
 	var b bytes.Buffer
-	err := t.Execute(&b, data)
+	err = t.Execute(&b, data)
 	if err != nil {
 		log.Printf("failed executing template %s: %s", asset, err)
 		return "", err
@@ -66,10 +223,25 @@ func render(asset string, data map[string]interface{}) (string, error) {
 	return b.String(), nil
 }
 
+// pageTitlePrefix returns the prefix of the pages' titles: the -title-prefix
+// if set, and otherwise the -name.
+func pageTitlePrefix() string {
+	if *titlePrefix != "" {
+		return *titlePrefix
+	}
+	return *demoName + ": "
+}
+
 func renderSimple(rw http.ResponseWriter, asset string, data map[string]interface{}) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	data["TitlePrefix"] = pageTitlePrefix()
 	html, err := render(asset, data)
 	if err != nil {
-		log.Fatal(err)
+		log.Print(err)
+		http.Error(rw, fmt.Sprintf("unable to render %s: %s", asset, err), http.StatusInternalServerError)
+		return
 	}
 	_, err = rw.Write([]byte(html))
 	if err != nil {
@@ -77,15 +249,59 @@ func renderSimple(rw http.ResponseWriter, asset string, data map[string]interfac
 	}
 }
 
+// nameHeader is the response header carrying the -name in the status APIs,
+// including /api/nodes, whose JSON is a list with no room for it.
+const nameHeader = "Roachdemo-Name"
+
+func writeJSON(rw http.ResponseWriter, v interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(rw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		log.Print(err)
+	}
+}
+
+// contextReader is an io.Reader which fails once its context is done, so that
+// an io.Copy from it is aborted promptly.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
+
+// copyStream copies src to the response until src is exhausted or the client
+// goes away. A client going away is not an error: the copy is simply
+// abandoned.
+func copyStream(rw http.ResponseWriter, req *http.Request, src io.Reader) error {
+	_, err := io.Copy(rw, contextReader{ctx: req.Context(), r: src})
+	if req.Context().Err() != nil {
+		return nil
+	}
+	return err
+}
+
 func renderError(rw http.ResponseWriter, message string) {
 	renderSimple(rw, "error.html", map[string]interface{}{"Error": message})
 }
 
-func renderLayout(rw http.ResponseWriter, asset string, layout string, key string,
+func renderLayout(rw http.ResponseWriter, req *http.Request, asset string, layout string, key string,
 	data map[string]interface{}) {
+	data["Name"] = *demoName
+	data["Refresh"] = refreshInterval(req)
+	data["AutoRefresh"] = refreshPages[fmt.Sprint(data["Page"])]
+	data["RefreshIntervals"] = refreshIntervals
 	html, err := render(asset, data)
 	if err != nil {
-		log.Fatal(err)
+		log.Print(err)
+		http.Error(rw, fmt.Sprintf("unable to render %s: %s", asset, err), http.StatusInternalServerError)
+		return
 	}
 	data[key] = template.HTML(html)
 	renderSimple(rw, layout, data)
@@ -107,7 +323,24 @@ func makeRoute(s string, fn routeFn) route {
 
 type routes []route
 
+// ServeHTTP strips -base-path from the request's path and dispatches the
+// request to the first matching route.
 func (routes routes) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if *basePath != "" {
+		if req.URL.Path == *basePath {
+			http.Redirect(rw, req, *basePath+"/", http.StatusMovedPermanently)
+			return
+		}
+		if !strings.HasPrefix(req.URL.Path, *basePath+"/") {
+			rw.WriteHeader(http.StatusNotFound)
+			renderSimple(rw, "notfound.html", nil)
+			return
+		}
+		u := *req.URL
+		u.Path = strings.TrimPrefix(u.Path, *basePath)
+		u.RawPath = ""
+		req.URL = &u
+	}
 	path := req.URL.Path
 	for _, r := range routes {
 		m := r.re.FindStringSubmatch(path)
@@ -130,7 +363,7 @@ func (routes routes) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 }
 
 func getCSS(rw http.ResponseWriter, req *http.Request, args map[string]string) {
-	asset, err := Asset("assets" + req.URL.Path)
+	asset, err := loadAsset("assets" + req.URL.Path)
 	if err != nil {
 		log.Print(err)
 		rw.WriteHeader(http.StatusNotFound)
@@ -145,67 +378,371 @@ func getCSS(rw http.ResponseWriter, req *http.Request, args map[string]string) {
 	}
 }
 
+func getJS(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	asset, err := loadAsset("assets" + req.URL.Path)
+	if err != nil {
+		log.Print(err)
+		rw.WriteHeader(http.StatusNotFound)
+		renderError(rw, fmt.Sprintf("assets%s not found", req.URL.Path))
+		return
+	}
+	rw.Header().Add("Content-Type", "application/javascript")
+	_, err = rw.Write(asset)
+	if err != nil {
+		log.Print(err)
+		return
+	}
+}
+
+// debugAsset returns the embedded source of the asset specified by the "name"
+// form value, either its full name or the unique asset whose name ends in
+// /name, ignoring any -assets-dir override. Only served with -dev.
+func debugAsset(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	name := req.FormValue("name")
+	var matches []string
+	for _, asset := range AssetNames() {
+		if asset == name {
+			matches = []string{asset}
+			break
+		}
+		if name != "" && strings.HasSuffix(asset, "/"+name) {
+			matches = append(matches, asset)
+		}
+	}
+	if len(matches) != 1 {
+		http.Error(rw, fmt.Sprintf("asset %q not found", name), http.StatusNotFound)
+		return
+	}
+	asset, err := Asset(matches[0])
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+	rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err := rw.Write(asset); err != nil {
+		log.Print(err)
+	}
+}
+
+// getFavicon serves the -favicon file, or the built-in icon if none is set.
+func getFavicon(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	var icon []byte
+	var err error
+	contentType := "image/x-icon"
+	if *favicon != "" {
+		icon, err = ioutil.ReadFile(*favicon)
+		if t := mime.TypeByExtension(filepath.Ext(*favicon)); t != "" {
+			contentType = t
+		} else {
+			contentType = http.DetectContentType(icon)
+		}
+	} else {
+		icon, err = loadAsset("assets/favicon.ico")
+	}
+	if err != nil {
+		log.Print(err)
+		http.NotFound(rw, req)
+		return
+	}
+	rw.Header().Set("Content-Type", contentType)
+	rw.Header().Set("Cache-Control", "max-age=86400")
+	if _, err := rw.Write(icon); err != nil {
+		log.Print(err)
+	}
+}
+
 func init() {
 	flag.Var(&attrs, "a", "(repeatable) attrs to be assigned to specific nodes in the form node_id:value e.g. -a=1:ssd -a=2:x16c:ssd")
+	flag.Var(&stores, "s", "(repeatable) store specs to be assigned to specific nodes in the form node_id:spec e.g. -s=1:type=mem,size=2GiB")
+	flag.Var(&maxOffsets, "o", "(repeatable) --max-offset to be assigned to specific nodes in the form node_id:duration e.g. -o=3:250ms; cockroach requires all nodes to agree")
 	flag.Var(&localities, "l", "(repeatable) localities to be assigned to specific nodes in the form node_id:locality e.g. -l=1:country=us,region=us-west -l=2:country=ca,region=ca-east")
 }
 
 func main() {
 	flag.Parse()
+	if *demoName == "" {
+		*demoName = defaultName()
+	}
+	statName.Set(*demoName)
 
-	for _, path := range AssetNames() {
-		if !strings.HasSuffix(path, ".html") {
-			continue
+	if err := parseTemplates(); err != nil {
+		log.Fatal(err)
+	}
+
+	if *basePath != "" && !strings.HasPrefix(*basePath, "/") {
+		log.Fatalf("-base-path must start with /: %s", *basePath)
+	}
+	*basePath = strings.TrimRight(*basePath, "/")
+
+	if err := validateHost(*nodeHost); err != nil {
+		log.Fatal(err)
+	}
+	if *httpHost != "" {
+		if err := validateHost(*httpHost); err != nil {
+			log.Fatalf("-http-host: %s", err)
+		}
+	}
+
+	if *maxPort != 0 && *maxPort <= basePort {
+		log.Fatalf("-max-port must be greater than %d", basePort)
+	}
+	if *sqlBasePort < 0 || *sqlBasePort > 65535 {
+		log.Fatalf("-sql-port: invalid port %d", *sqlBasePort)
+	}
+	if *sqlBasePort != 0 && *sqlBasePort >= basePort {
+		// Without -max-port the node port range is unbounded, so the SQL
+		// ports can only be placed below it.
+		if *maxPort == 0 {
+			log.Fatalf("-sql-port must be below the node port range starting at %d unless -max-port is set", basePort)
+		}
+		if *sqlBasePort <= *maxPort {
+			log.Fatalf("-sql-port must be outside of the node port range %d-%d", basePort, *maxPort)
+		}
+	}
+
+	if (*tlsCert == "") != (*tlsKey == "") {
+		log.Fatal("-tls-cert and -tls-key must be set together")
+	}
+	if *tlsCert != "" {
+		// Load the pair up front so that a missing or mismatched file is
+		// reported before any nodes are started.
+		if _, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey); err != nil {
+			log.Fatalf("-tls-cert/-tls-key: %s", err)
+		}
+	}
+	if *commandTimeout <= 0 {
+		log.Fatalf("-command-timeout must be positive: %s", *commandTimeout)
+	}
+
+	if *recordFile != "" && *demoMode {
+		log.Fatal("-record can't be combined with -demo")
+	}
+	if *raceBinary != "" {
+		if *dockerImage != "" || *demoMode {
+			log.Fatal("-race-binary can't be combined with -docker or -demo")
+		}
+		if _, err := exec.LookPath(*raceBinary); err != nil {
+			log.Fatalf("-race-binary: %s", err)
+		}
+	}
+	if *importDir != "" && (*dockerImage != "" || *demoMode) {
+		log.Fatal("-import can't be combined with -docker or -demo")
+	}
+
+	if err := checkMaxOffsets(*maxOffset, maxOffsets); err != nil {
+		log.Fatal(err)
+	}
+
+	if *syslogAddr != "" {
+		s, err := newSyslogSink(*syslogAddr)
+		if err != nil {
+			log.Fatalf("-syslog-addr: %s", err)
 		}
-		t := template.New(path)
-		asset, err := Asset(path)
+		logSinks = append(logSinks, s)
+	}
+	if *lokiURL != "" {
+		s, err := newLokiSink(*lokiURL)
 		if err != nil {
-			log.Fatal(err)
+			log.Fatalf("-loki-url: %s", err)
+		}
+		logSinks = append(logSinks, s)
+	}
+
+	c := newCluster(flag.Args(), attrs, localities, stores, maxOffsets, *nodeHost, *httpHost)
+	defer c.close()
+
+	if *sqlPasswordFile != "" {
+		b, err := ioutil.ReadFile(*sqlPasswordFile)
+		if err != nil {
+			log.Fatalf("-sql-password-file: %s", err)
 		}
-		if _, err := t.Parse(string(asset)); err != nil {
+		sqlPassword = strings.TrimRight(string(b), "\r\n")
+	}
+
+	if *argsFile != "" {
+		fileArgs, err := readArgsFile(*argsFile)
+		if err != nil {
 			log.Fatal(err)
 		}
-		tmpls[filepath.Base(path)] = t
+		c.setFileArgs(fileArgs)
+		if *watch {
+			go c.watchArgsFile(*argsFile, *watchRestart)
+		}
 	}
 
-	c := newCluster(flag.Args(), attrs, localities)
-	defer c.close()
+	if *recordFile != "" {
+		if err := c.recorder.open(*recordFile); err != nil {
+			log.Fatalf("-record: %s", err)
+		}
+	}
+
+	if *demoMode {
+		d, err := startDemo(filepath.Join(dataDir, "demo.log"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		c.Demo = d
+	} else if *importDir != "" {
+		stores, err := scanStores(*importDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(stores) == 0 {
+			log.Fatalf("no cockroach stores found in %s", *importDir)
+		}
+		if err := c.importStores(stores, *bootConcurrency, *bootStagger); err != nil {
+			log.Fatal(err)
+		}
+		if extra := *numNodes - len(stores); extra > 0 {
+			if err := c.portsAvailable(extra); err != nil {
+				log.Fatal(err)
+			}
+			if err := c.boot(extra, *bootConcurrency, *bootStagger); err != nil {
+				log.Fatal(err)
+			}
+		}
+	} else {
+		paths, _ := filepath.Glob(filepath.Join(dataDir, "[0-9]*"))
+		count := len(paths)
+		if count < *numNodes {
+			count = *numNodes
+		}
+		if err := c.portsAvailable(count); err != nil {
+			log.Fatal(err)
+		}
+		if err := c.boot(count, *bootConcurrency, *bootStagger); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *autoInit && !*demoMode {
+		go c.autoInit(*initTimeout)
+	}
 
-	paths, _ := filepath.Glob(filepath.Join(dataDir, "*"))
-	for range paths {
-		c.newNode()
+	go c.reaper()
+	if *reconcileInterval > 0 {
+		go c.reconciler(*reconcileInterval)
 	}
-	for len(c.Nodes) < *numNodes {
-		c.newNode()
+	if *compressOldLogs > 0 {
+		go c.logCompressor(*compressOldLogs)
 	}
+	go c.sampleResources()
+	go c.probeHealth()
 
 	routes := routes{
 		makeRoute(`/`, c.showCluster),
 		makeRoute(`/add`, c.addNode),
 		makeRoute(`/stopall`, c.stopAll),
 		makeRoute(`/startall`, c.startAll),
+		makeRoute(`/schedule`, c.scheduleAll),
+		makeRoute(`/schedule/cancel`, c.cancelSchedule),
 		makeRoute(`/pauseall`, c.pauseAll),
+		makeRoute(`/replay`, c.startReplay),
+		makeRoute(`/replay/cancel`, c.cancelReplay),
 		makeRoute(`/resumeall`, c.resumeAll),
+		makeRoute(`/flush-all`, c.flushAll),
+		makeRoute(`/stacks.zip`, c.stacksZip),
+		makeRoute(`/finalize-upgrade`, c.finalizeUpgrade),
+		makeRoute(`/selfcheck`, c.selfCheck),
+		makeRoute(`/log-level`, c.setLogLevel),
+		makeRoute(`/events`, c.showEvents),
+		makeRoute(`/ports`, c.showPorts),
+		makeRoute(`/settings-diff`, c.settingsDiff),
+		makeRoute(`/leases`, c.showLeases),
+		makeRoute(`/api/quorum`, c.apiQuorum),
+		makeRoute(`/api/cluster`, c.apiCluster),
+		makeRoute(`/api/nodes`, c.apiNodes),
+		makeRoute(`/api/nodes/(?P<node>[^/]+)`, c.apiNodeResource),
+		makeRoute(`/api/nodes/(?P<node>[^/]+)/(?P<action>start|stop|pause|resume|restart)`, c.apiNodeAction),
+		makeRoute(`/api/events`, c.apiEvents),
+		makeRoute(`/api/nodes/(?P<node>[^/]+)/wait-ready`, c.apiWaitReady),
+		makeRoute(`/api/export`, c.apiExport),
+		makeRoute(`/api/import`, c.apiImport),
+		makeRoute(`/validate-args`, c.apiValidateArgs),
+		makeRoute(`/quit`, c.quitServer),
+
+		makeRoute(`/tenant/add`, c.addTenant),
+		makeRoute(`/tenant/(?P<tenant>[^/]+)/start`, c.startTenant),
+		makeRoute(`/tenant/(?P<tenant>[^/]+)/stop`, c.stopTenant),
 
 		makeRoute(`/node/(?P<node>[^/]+)/start`, c.startNode),
 		makeRoute(`/node/(?P<node>[^/]+)/stop`, c.stopNode),
 		makeRoute(`/node/(?P<node>[^/]+)/pause`, c.pauseNode),
+		makeRoute(`/node/(?P<node>[^/]+)/signal`, c.signalNode),
+		makeRoute(`/node/(?P<node>[^/]+)/reopen-logs`, c.reopenLogs),
+		makeRoute(`/node/(?P<node>[^/]+)/seed`, c.seedNode),
+		makeRoute(`/node/(?P<node>[^/]+)/mem-limit`, c.setMemLimit),
+		makeRoute(`/node/(?P<node>[^/]+)/cpus`, c.setCPUs),
+		makeRoute(`/node/(?P<node>[^/]+)/numa`, c.setNUMA),
 		makeRoute(`/node/(?P<node>[^/]+)/resume`, c.resumeNode),
+		makeRoute(`/node/(?P<node>[^/]+)/zombie`, c.zombieNode),
+		makeRoute(`/node/(?P<node>[^/]+)/revive`, c.reviveNode),
+		makeRoute(`/node/(?P<node>[^/]+)/block`, c.blockNode),
+		makeRoute(`/node/(?P<node>[^/]+)/unblock`, c.unblockNode),
+		makeRoute(`/node/(?P<node>[^/]+)/upgrade`, c.requireConfirmation("upgrade",
+			"The node is restarted running the binary.", c.upgradeNode)),
+		makeRoute(`/node/(?P<node>[^/]+)/pin-binary`, c.pinBinary),
+		makeRoute(`/node/(?P<node>[^/]+)/set`, c.setNodePlacement),
+		makeRoute(`/node/(?P<node>[^/]+)/ready-cmd`, c.requireConfirmation("set readiness command",
+			"roachdemo runs the command with /bin/sh to probe the node.", c.setReadyCommand)),
+		makeRoute(`/node/(?P<node>[^/]+)/reap`, c.reapNode),
+		makeRoute(`/node/(?P<node>[^/]+)/reset-backoff`, c.resetNodeBackoff),
+		makeRoute(`/node/(?P<node>[^/]+)/slow-start`, c.slowStartNode),
+		makeRoute(`/node/(?P<node>[^/]+)/trace`, c.traceNode),
+		makeRoute(`/node/(?P<node>[^/]+)/ranges`, c.nodeRanges),
+		makeRoute(`/node/(?P<node>[^/]+)/ranges/log`, c.nodeRangesLog),
+		makeRoute(`/node/(?P<node>[^/]+)/hooks/log`, c.nodeHooksLog),
+		makeRoute(`/node/(?P<node>[^/]+)/pprof/(?P<profile>heap|goroutine|profile)`, c.nodePprof),
 
 		makeRoute(`/node/(?P<node>[^/]+)`, c.nodeHistory),
+		makeRoute(`/node/(?P<node>[^/]+)/history.csv`, c.nodeHistoryCSV),
 		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)`, c.nodeRunPage),
 		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/stdout`, c.nodeRunStdout),
+		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/trace`, c.nodeRunTrace),
 		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/stderr`, c.nodeRunStderr),
+		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/rerun`, c.rerunNode),
+		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/pin`, c.pinNodeRun),
+		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/note`, c.noteNodeRun),
+		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/ws-log/(?P<type>stdout|stderr)`, c.nodeRunWSLog),
+		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/follow/(?P<type>stdout|stderr)`, c.nodeRunFollow),
+		makeRoute(`/node/(?P<node>[^/]+)/run/(?P<run>\d+)/tail.ndjson`, c.nodeRunTailNDJSON),
 
 		makeRoute(`/css/(?P<file>.*)`, getCSS),
+		makeRoute(`/js/(?P<file>.*)`, getJS),
+		makeRoute(`/favicon.ico`, getFavicon),
+		makeRoute(`/debug/vars`, debugVars),
+	}
+	if *devMode {
+		routes = append(routes, makeRoute(`/debug/asset`, debugAsset))
 	}
 
 	s := &http.Server{
-		Addr:    "localhost:9999",
+		Addr:    listenAddr,
 		Handler: routes,
 	}
-	log.Printf("serving: http://%s", s.Addr)
-	if err := s.ListenAndServe(); err != nil {
+	errCh := make(chan error, 1)
+	if *tlsCert != "" {
+		log.Printf("serving: https://%s%s/ (TLS enabled)", s.Addr, *basePath)
+		go func() {
+			errCh <- s.ListenAndServeTLS(*tlsCert, *tlsKey)
+		}()
+	} else {
+		log.Printf("serving: http://%s%s/ (TLS disabled)", s.Addr, *basePath)
+		go func() {
+			errCh <- s.ListenAndServe()
+		}()
+	}
+	select {
+	case err := <-errCh:
 		log.Fatal(err)
+	case <-c.quit:
+	}
+
+	log.Printf("quitting")
+	c.close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		log.Print(err)
 	}
+	os.Exit(0)
 }
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	if err := parseTemplates(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
+func TestLoadAssetContained(t *testing.T) {
+	root, err := ioutil.TempDir("", "roachdemo-assets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	dir := filepath.Join(root, "assets")
+	if err := os.MkdirAll(filepath.Join(dir, "css"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "css", "default.css"), []byte("override"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "secret"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(d string) { *assetsDir = d }(*assetsDir)
+	*assetsDir = dir
+
+	if b, err := loadAsset("assets/css/default.css"); err != nil || string(b) != "override" {
+		t.Fatalf("expected the override, got %q, %v", b, err)
+	}
+	for _, name := range []string{
+		"assets/../secret",
+		"assets/css/../../secret",
+		"assets/../../" + filepath.Base(root) + "/secret",
+		"/etc/passwd",
+	} {
+		if b, err := loadAsset(name); err == nil && string(b) == "secret" {
+			t.Errorf("%s: read a file outside of the assets dir", name)
+		}
+	}
+}
+
+// inTempDir changes to a temporary directory for the duration of the test, so
+// that nodes are created under it.
+func inTempDir(t *testing.T) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "roachdemo-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+		os.RemoveAll(dir)
+	})
+}
+
+func TestRefreshOnlyStatusPages(t *testing.T) {
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	confirm := c.requireConfirmation("remove everything", "", func(http.ResponseWriter, *http.Request, map[string]string) {})
+	testCases := []struct {
+		name    string
+		fn      routeFn
+		refresh bool
+	}{
+		{"dashboard", c.showCluster, true},
+		{"events", c.showEvents, true},
+		{"ports", c.showPorts, true},
+		{"self check", c.selfCheck, false},
+		{"confirm", confirm, false},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest("GET", "/", nil)
+		req.AddCookie(&http.Cookie{Name: refreshCookie, Value: "5"})
+		rw := httptest.NewRecorder()
+		tc.fn(rw, req, nil)
+		if rw.Code != http.StatusOK {
+			t.Fatalf("%s: expected the page to render, got %d: %s", tc.name, rw.Code, rw.Body)
+		}
+		if refresh := strings.Contains(rw.Body.String(), `http-equiv="refresh"`); refresh != tc.refresh {
+			t.Errorf("%s: expected refresh=%t, got %t", tc.name, tc.refresh, refresh)
+		}
+	}
+}
+
+func TestPageTitlePrefix(t *testing.T) {
+	defer func(name, prefix string) { *demoName, *titlePrefix = name, prefix }(*demoName, *titlePrefix)
+	*demoName = "staging"
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	for _, tc := range []struct {
+		prefix, title string
+	}{
+		{"", "<title>staging: cluster</title>"},
+		{"[prod] ", "<title>[prod] cluster</title>"},
+	} {
+		*titlePrefix = tc.prefix
+		rw := httptest.NewRecorder()
+		c.showCluster(rw, httptest.NewRequest("GET", "/", nil), nil)
+		if !strings.Contains(rw.Body.String(), tc.title) {
+			t.Errorf("-title-prefix=%q: expected %s", tc.prefix, tc.title)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultMaxOffset is cockroach's default --max-offset.
+const defaultMaxOffset = 500 * time.Millisecond
+
+// MaxOffset returns the node's --max-offset, or "" if it uses the default.
+func (n *node) MaxOffset() string {
+	var offset string
+	for _, arg := range n.Args {
+		if strings.HasPrefix(arg, "--max-offset=") {
+			offset = strings.TrimPrefix(arg, "--max-offset=")
+		}
+	}
+	return offset
+}
+
+// parseMaxOffset parses a --max-offset value, "" being the default.
+func parseMaxOffset(offset string) (time.Duration, error) {
+	if offset == "" {
+		return defaultMaxOffset, nil
+	}
+	return time.ParseDuration(offset)
+}
+
+// checkMaxOffsets validates the -max-offset and -o flags, warning about
+// nodes whose max offset differs from the cluster's: cockroach requires all
+// nodes to agree on it and refuses connections from nodes which don't.
+func checkMaxOffsets(offset string, offsets perNodeAttribute) error {
+	d, err := parseMaxOffset(offset)
+	if err != nil {
+		return fmt.Errorf("-max-offset: %s", err)
+	}
+	var ids []int
+	for id := range offsets {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		nd, err := parseMaxOffset(offsets[id])
+		if err != nil {
+			return fmt.Errorf("-o %d: %s", id, err)
+		}
+		if nd != d {
+			log.Printf("warning: node %d has max offset %s but the cluster has %s; cockroach requires all nodes to agree",
+				id, nd, d)
+		}
+	}
+	return nil
+}
+
+// MaxOffsetWarning returns a description of the nodes whose max offset
+// differs from t's, or "" if all nodes agree.
+func (c *cluster) MaxOffsetWarning(t *node) string {
+	d, err := parseMaxOffset(t.MaxOffset())
+	if err != nil {
+		return fmt.Sprintf("invalid max offset: %s", err)
+	}
+	var differing []string
+	for _, o := range c.sortedNodes() {
+		if o == t {
+			continue
+		}
+		if od, err := parseMaxOffset(o.MaxOffset()); err != nil || od != d {
+			offset := o.MaxOffset()
+			if offset == "" {
+				offset = "the default " + defaultMaxOffset.String()
+			}
+			differing = append(differing, fmt.Sprintf("node %s has %s", o.Name, offset))
+		}
+	}
+	if len(differing) == 0 {
+		return ""
+	}
+	return strings.Join(differing, ", ") + "; cockroach requires all nodes to agree on --max-offset"
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+var byteSizeRE = regexp.MustCompile(`^(\d+)\s*(B|KB|MB|GB|TB|KiB|MiB|GiB|TiB)?$`)
+
+var byteSizeUnits = map[string]int64{
+	"":    1,
+	"B":   1,
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"TB":  1000 * 1000 * 1000 * 1000,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+	"TiB": 1 << 40,
+}
+
+// parseByteSize parses a size such as 512MiB, 2GB or 1073741824.
+func parseByteSize(s string) (int64, error) {
+	m := byteSizeRE.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return n * byteSizeUnits[m[2]], nil
+}
+
+// checkMemLimit returns an error if the node's runs can't be given a memory
+// limit. Docker nodes use docker's --memory; other nodes are run in a
+// transient systemd scope with MemoryMax set, which requires Linux and
+// systemd-run.
+func (n *node) checkMemLimit() error {
+	if n.Container != "" {
+		return nil
+	}
+	if runtime.GOOS != "linux" {
+		return fmt.Errorf("memory limits require Linux or -docker, not %s", runtime.GOOS)
+	}
+	if _, err := exec.LookPath("systemd-run"); err != nil {
+		return fmt.Errorf("memory limits require systemd-run: %s", err)
+	}
+	// This is how sd_booted(3) checks whether systemd is running.
+	if _, err := os.Stat("/run/systemd/system"); err != nil {
+		return fmt.Errorf("memory limits require systemd to be running")
+	}
+	return nil
+}
+
+// memLimitArgs returns args modified to run the process with a memory limit
+// of the specified number of bytes, with swap disabled so that exceeding the
+// limit gets the process OOM-killed rather than swapped.
+func (n *node) memLimitArgs(args []string, limit int64) []string {
+	if n.Container != "" {
+		// args are "docker run ...".
+		return append([]string{args[0], args[1],
+			fmt.Sprintf("--memory=%d", limit), fmt.Sprintf("--memory-swap=%d", limit)}, args[2:]...)
+	}
+	wrapper := []string{"systemd-run"}
+	if os.Geteuid() != 0 {
+		wrapper = append(wrapper, "--user")
+	}
+	wrapper = append(wrapper, "--scope", "--quiet",
+		fmt.Sprintf("--property=MemoryMax=%d", limit), "--property=MemorySwapMax=0", "--")
+	return append(wrapper, args...)
+}
+
+// looksOOMKilled returns whether the run's exit looks like it was killed by
+// the OOM killer: by SIGKILL, or with status 137 for docker which reports
+// the container's exit status. Only runs with a memory limit are considered,
+// as a SIGKILL is otherwise far more likely to come from a user.
+func (r *nodeRun) looksOOMKilled() bool {
+	if r.MemLimit <= 0 {
+		return false
+	}
+	if r.Container != "" {
+		return r.WaitStatus.Exited() && r.WaitStatus.ExitStatus() == 128+int(syscall.SIGKILL)
+	}
+	return r.WaitStatus.Signaled() && r.WaitStatus.Signal() == syscall.SIGKILL
+}
+
+// setMemLimit sets the memory limit of the node's runs to the "max" form
+// value, e.g. 1GiB, restarting the node if it is running. An empty value
+// removes the limit.
+func (c *cluster) setMemLimit(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	limit := strings.TrimSpace(req.FormValue("max"))
+	if limit != "" {
+		n, err := parseByteSize(limit)
+		if err == nil && n <= 0 {
+			err = fmt.Errorf("invalid size %q: must be positive", limit)
+		}
+		if err == nil {
+			err = t.checkMemLimit()
+		}
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, err.Error())
+			return
+		}
+	}
+
+	if limit != t.MemLimit {
+		if limit == "" {
+			c.events.add(t.Name, "memory limit removed")
+		} else {
+			c.events.add(t.Name, "memory limit set to %s", limit)
+		}
+		t.MemLimit = limit
+		if t.Active() != nil {
+			t.gracefulRestart()
+		}
+	}
+
+	redirect(rw, req)
+}
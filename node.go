@@ -1,14 +1,20 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"os"
 	"os/exec"
 	"os/user"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 )
@@ -20,15 +26,132 @@ type node struct {
 	Stdout   string
 	Stderr   string
 	URL      string
-	Attrs    string
-	Locality string
+	Host     string
+	Port     int
+	HTTPPort int
+	Dir      string
+	// SQLPort is the port the node serves SQL on, which is Port unless the
+	// node was started with --sql-addr.
+	SQLPort int
+	// Store is the --store spec of the node, either the path of its data
+	// directory or a spec such as type=mem,size=2GiB.
+	Store string
+	// Container is the name of the docker container the node runs in, if
+	// any.
+	Container string
+	Attrs     string
+	Locality  string
 
-	Active *nodeRun
+	// active is the node's running run, if any (see Active).
+	active *nodeRun
 	Runs   []*nodeRun
 
 	Service bool
+
+	// ReadyCommand, if set, is a shell command which exits successfully once
+	// the node is ready, used instead of probing the node's health endpoint.
+	// It is expanded with the node's variables (see readyVars).
+	ReadyCommand string
+	health       healthState
+
+	// PreStartHook and PostStopHook, if set, are shell commands run
+	// synchronously before each run is started and after it exits (see
+	// runHook). A failing pre-start hook aborts the start and its error is
+	// recorded in HookError.
+	PreStartHook string
+	PostStopHook string
+	HookError    string
+
+	// EnvSources records where each variable in Env came from: one of
+	// envInherited, envDefault or envOverride.
+	EnvSources map[string]string
+
+	// Failures is the number of consecutive runs which exited unexpectedly
+	// within stableRunDuration, Backoff is the delay before the next
+	// automatic restart and Disabled indicates that automatic restarts were
+	// stopped after maxFailures consecutive failures.
+	Failures int
+	Backoff  time.Duration
+	Disabled bool
+
+	Resources resourceHistory
+
+	// SlowStart is a delay injected before the process of the next run is
+	// executed, simulating a node which is slow to come up.
+	SlowStart time.Duration
+	// TraceNext is the tracer, strace or ltrace, the next run is started
+	// under, if any.
+	TraceNext string
+
+	// PinnedBinary, if set, is the only binary the node may be started with
+	// and PinnedVersion is its version when it was pinned, guarding staged
+	// upgrades against accidental version changes (see checkPinnedBinary).
+	// PinError records why the last start was refused.
+	PinnedBinary  string
+	PinnedVersion string
+	PinError      string
+
+	// CPUs is the number of vCPUs the node's runs are started with via
+	// GOMAXPROCS, or 0 for all of the host's, and CPUSet is the list of
+	// cores they are pinned to, if any.
+	CPUs   int
+	CPUSet string
+
+	// NUMANode is the NUMA node the node's runs are bound to with numactl,
+	// or "" for none (see numaArgs).
+	NUMANode string
+
+	// RunTooShort is set while the node's runs exit successfully almost
+	// immediately, suggesting that the command daemonizes (see
+	// looksDaemonized).
+	RunTooShort bool
+
+	// MemLimit is the memory limit the node's runs are started with, e.g.
+	// 1GiB, or "" for none (see memLimitArgs).
+	MemLimit string
+
+	// StoreLocked is the store directory the node's last run found locked
+	// by another process, after which the node isn't restarted until it is
+	// started again (see lockedStore).
+	StoreLocked string
+
+	// CockroachID is the node ID cockroach assigned the node when it joined
+	// the cluster, or 0 if it isn't known yet (see refreshNodeIDs).
+	CockroachID int
+
+	// Blocked maps the peers the node's outbound traffic is blocked from
+	// (see block) to the ports of each which are blocked.
+	Blocked map[string][]int
+
+	// Notes maps the IDs of the node's runs to the user's annotations of
+	// them, persisted to notesFile in the node's directory so that they
+	// survive restarts of roachdemo.
+	Notes map[int]string
+
+	// freshDir is set if the node's data directory was created along with
+	// the node rather than left by a previous roachdemo (see discardNode).
+	freshDir bool
+
+	// starting is set while a run is being started, so that concurrent
+	// start requests (e.g. a double-clicked Start button) are idempotent.
+	// startMu guards starting and active, which is replaced by requests
+	// and by the goroutine waiting for the run to exit.
+	startMu  sync.Mutex
+	starting bool
 }
 
+const (
+	// stableRunDuration is how long a run must last to reset the failure
+	// count.
+	stableRunDuration = 30 * time.Second
+	// shortRunDuration is the duration below which a run exiting
+	// successfully looks like it daemonized.
+	shortRunDuration  = 2 * time.Second
+	minRestartBackoff = time.Second
+	maxRestartBackoff = time.Minute
+	maxFailures       = 10
+)
+
 type nodeRun struct {
 	ID         int
 	Cmd        *exec.Cmd
@@ -45,6 +168,59 @@ type nodeRun struct {
 	Env        map[string]string
 	WaitStatus syscall.WaitStatus
 	Paused     bool
+	// Zombie marks a paused run as a zombie (see zombify), and HealthLie
+	// has roachdemo's readiness probes report it ready while it is one.
+	Zombie    bool
+	HealthLie bool
+	// Pinned marks the run as the node's known-good baseline.
+	Pinned bool
+	// Delay is the slow start delay injected before the process was
+	// executed.
+	Delay time.Duration
+	// Tracer is the tracer the process was run under, if any, and tracePath
+	// is where its trace is written.
+	Tracer    string
+	tracePath string
+	// MemLimit is the memory limit in bytes the process was run with, if
+	// any, and OOMKilled indicates that the process exited unexpectedly in
+	// a way that looks like an OOM kill.
+	MemLimit  int64
+	OOMKilled bool
+	// TooShort indicates that the process exited successfully almost
+	// immediately after starting, which suggests that it daemonized.
+	TooShort bool
+	// CPUs is the number of vCPUs the process was limited to, if any.
+	CPUs int
+	// NUMANode is the NUMA node the process was bound to, if any.
+	NUMANode string
+	// Build is the build of the cockroach binary run, if known.
+	Build *cockroachBuild
+	// StoreLocked indicates that the run exited because its store was
+	// locked by another process.
+	StoreLocked bool
+	// Race indicates that the run executes the -race-binary. The data race
+	// reports in its output are tracked by races.
+	Race  bool
+	races *raceDetector
+	// Merged indicates that stderr is captured in the stdout stream.
+	Merged bool
+	// Container is the name of the docker container the run executes in, if
+	// any. Signals must be delivered through docker rather than to the
+	// docker client process.
+	Container string
+	// node is the name of the node the run belongs to.
+	node string
+	// stdoutIndex and stderrIndex index the timestamps of the logs for
+	// seeking. They are built as the logs are written, or from the log
+	// files when first needed.
+	indexMu     sync.Mutex
+	stdoutIndex *logIndex
+	stderrIndex *logIndex
+	// done is closed once the process has exited.
+	done chan struct{}
+	// hooked is closed once the post-stop hook has run after the process
+	// exited.
+	hooked chan struct{}
 }
 
 func (r *nodeRun) String() string {
@@ -57,24 +233,39 @@ func (r *nodeRun) Command() string {
 
 func (r *nodeRun) start(exitCh chan struct{}) {
 	r.Started = time.Now()
+	r.done = make(chan struct{})
 
 	if len(r.Stdout) > 0 {
 		wr, err := newFileLogWriter(r.Stdout)
 		if err != nil {
 			log.Fatalf("unable to open file %s: %s", r.Stdout, err.Error())
 		}
-		r.StdoutBuf = wr
+		r.stdoutIndex = &logIndex{}
+		var w logWriter = indexLogWriter{wr, r.stdoutIndex}
+		if r.races != nil && r.Merged {
+			w = raceLogWriter{w, r.races}
+		}
+		r.StdoutBuf = teeLogs(w, r.node, r.ID, "stdout")
 	}
 	r.Cmd.Stdout = r.StdoutBuf
 
-	if len(r.Stderr) > 0 {
-		wr, err := newFileLogWriter(r.Stderr)
-		if err != nil {
-			log.Fatalf("unable to open file %s: %s", r.Stderr, err.Error())
+	if r.Merged {
+		r.Cmd.Stderr = r.Cmd.Stdout
+	} else {
+		if len(r.Stderr) > 0 {
+			wr, err := newFileLogWriter(r.Stderr)
+			if err != nil {
+				log.Fatalf("unable to open file %s: %s", r.Stderr, err.Error())
+			}
+			r.stderrIndex = &logIndex{}
+			var w logWriter = indexLogWriter{wr, r.stderrIndex}
+			if r.races != nil {
+				w = raceLogWriter{w, r.races}
+			}
+			r.StderrBuf = teeLogs(w, r.node, r.ID, "stderr")
 		}
-		r.StderrBuf = wr
+		r.Cmd.Stderr = r.StderrBuf
 	}
-	r.Cmd.Stderr = r.StderrBuf
 
 	for k, v := range r.Env {
 		r.Cmd.Env = append(r.Cmd.Env, k+"="+v)
@@ -87,43 +278,243 @@ func (r *nodeRun) start(exitCh chan struct{}) {
 	if err != nil {
 		r.Error = err
 		log.Printf(err.Error())
-		r.StdoutBuf.Close()
-		r.StderrBuf.Close()
+		r.closeOutput()
+		close(r.done)
 		exitCh <- struct{}{}
 		return
 	}
 	go func() {
 		r.Cmd.Wait()
 
-		r.StdoutBuf.Close()
-		r.StderrBuf.Close()
+		r.closeOutput()
 
 		ps := r.Cmd.ProcessState
 		sy := ps.Sys().(syscall.WaitStatus)
+		r.WaitStatus = sy
 
 		log.Printf("Process %d exited with status %d", ps.Pid(), sy.ExitStatus())
 		log.Printf(ps.String())
 
 		r.Stopped = time.Now()
+		close(r.done)
 		exitCh <- struct{}{}
 	}()
 }
 
+// notesFile is the file in a node's directory holding the notes of its
+// runs.
+const notesFile = "notes.json"
+
+// loadNotes reads the notes of the node's runs from its directory.
+func (n *node) loadNotes() error {
+	if n.Dir == "" {
+		return nil
+	}
+	b, err := ioutil.ReadFile(filepath.Join(n.Dir, notesFile))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, &n.Notes)
+}
+
+// RunNotes returns the notes of the node's run.
+func (n *node) RunNotes(run int) string {
+	return n.Notes[run]
+}
+
+// setNotes sets the notes of the node's run, persisting them to the node's
+// directory. Empty notes are removed.
+func (n *node) setNotes(run int, notes string) error {
+	if n.Notes == nil {
+		n.Notes = map[int]string{}
+	}
+	if notes == "" {
+		delete(n.Notes, run)
+	} else {
+		n.Notes[run] = notes
+	}
+	if n.Dir == "" {
+		return nil
+	}
+	b, err := json.Marshal(n.Notes)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(n.Dir, notesFile), b, 0644)
+}
+
+// syncOutput syncs the run's log files to disk.
+func (r *nodeRun) syncOutput() error {
+	for _, w := range []logWriter{r.StdoutBuf, r.StderrBuf} {
+		if w == nil {
+			continue
+		}
+		if err := w.Sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *nodeRun) closeOutput() {
+	if r.StdoutBuf != nil {
+		r.StdoutBuf.Close()
+	}
+	if r.StderrBuf != nil {
+		r.StderrBuf.Close()
+	}
+}
+
+// stdoutLog returns the writer capturing stdout or, if the run's buffers have
+// been pruned, a reader of its log file. It returns nil if stdout is not
+// being captured.
+func (r *nodeRun) stdoutLog() logWriter {
+	if r.StdoutBuf != nil {
+		return r.StdoutBuf
+	}
+	if r.Stdout != "" {
+		return fileLogWriter{filename: r.Stdout}
+	}
+	return nil
+}
+
+// stderrLog is the stderr equivalent of stdoutLog.
+func (r *nodeRun) stderrLog() logWriter {
+	if r.StderrBuf != nil {
+		return r.StderrBuf
+	}
+	if r.Stderr != "" {
+		return fileLogWriter{filename: r.Stderr}
+	}
+	return nil
+}
+
+// StdoutLen returns the size of the captured stdout, or 0 if stdout is not
+// being captured.
+func (r *nodeRun) StdoutLen() int64 {
+	w := r.stdoutLog()
+	if w == nil {
+		return 0
+	}
+	return w.Len()
+}
+
+// StderrLen returns the size of the captured stderr, or 0 if stderr is not
+// being captured.
+func (r *nodeRun) StderrLen() int64 {
+	w := r.stderrLog()
+	if w == nil {
+		return 0
+	}
+	return w.Len()
+}
+
+// StdoutString returns the captured stdout, or "" if stdout is not being
+// captured.
+func (r *nodeRun) StdoutString() string {
+	w := r.stdoutLog()
+	if w == nil {
+		return ""
+	}
+	return w.String()
+}
+
+// StderrString returns the captured stderr, or "" if stderr is not being
+// captured.
+func (r *nodeRun) StderrString() string {
+	w := r.stderrLog()
+	if w == nil {
+		return ""
+	}
+	return w.String()
+}
+
+// docker runs a docker subcommand against the run's container.
+func (r *nodeRun) docker(cmd string) {
+	out, err := exec.Command("docker", cmd, r.Container).CombinedOutput()
+	if err != nil {
+		log.Printf("docker %s %s: %s: %s", cmd, r.Container, err, out)
+	}
+}
+
+// signals are the signals which may be sent to a run on request. SIGKILL,
+// SIGSTOP and SIGCONT are excluded as stop, pause and resume cover them and
+// keep the run's state consistent.
+var signals = map[string]syscall.Signal{
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGINT":  syscall.SIGINT,
+	"SIGQUIT": syscall.SIGQUIT,
+	"SIGTERM": syscall.SIGTERM,
+	"SIGUSR1": syscall.SIGUSR1,
+	"SIGUSR2": syscall.SIGUSR2,
+}
+
+// signal sends the named signal, which must be one of signals, to the run's
+// process.
+func (r *nodeRun) signal(name string) error {
+	sig, ok := signals[name]
+	if !ok {
+		return fmt.Errorf("unsupported signal %q", name)
+	}
+	if r.Cmd == nil || r.Cmd.Process == nil {
+		return fmt.Errorf("run %d has no process", r.ID)
+	}
+
+	if r.Container != "" {
+		out, err := exec.Command("docker", "kill", "--signal="+name, r.Container).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("%s: %s", err, out)
+		}
+		return nil
+	}
+	return r.Cmd.Process.Signal(sig)
+}
+
 func (r *nodeRun) stop() {
 	if r.Cmd == nil || r.Cmd.Process == nil {
 		return
 	}
 
 	r.Paused = false
+	r.Zombie, r.HealthLie = false, false
+	if r.Container != "" {
+		r.docker("stop")
+		return
+	}
 	r.Cmd.Process.Kill()
 }
 
+// gracefulStop sends SIGTERM to the process and waits up to timeout for it
+// to exit before killing it.
+func (r *nodeRun) gracefulStop(timeout time.Duration) {
+	if r.Cmd == nil || r.Cmd.Process == nil {
+		return
+	}
+
+	if r.Paused {
+		r.resume()
+	}
+	r.Cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case <-r.done:
+	case <-time.After(timeout):
+		r.stop()
+		<-r.done
+	}
+}
+
 func (r *nodeRun) pause() {
 	if r.Cmd == nil || r.Cmd.Process == nil {
 		return
 	}
 
 	r.Paused = true
+	if r.Container != "" {
+		r.docker("pause")
+		return
+	}
 	r.Cmd.Process.Signal(syscall.SIGSTOP)
 }
 
@@ -133,6 +524,11 @@ func (r *nodeRun) resume() {
 	}
 
 	r.Paused = false
+	r.Zombie, r.HealthLie = false, false
+	if r.Container != "" {
+		r.docker("unpause")
+		return
+	}
 	r.Cmd.Process.Signal(syscall.SIGCONT)
 }
 
@@ -140,6 +536,8 @@ type logWriter interface {
 	Write(p []byte) (n int, err error)
 	String() string
 	Len() int64
+	ModTime() time.Time
+	Sync() error
 	Close()
 }
 
@@ -168,8 +566,25 @@ func (w fileLogWriter) Write(p []byte) (n int, err error) {
 	return w.file.Write(p)
 }
 
+func (w fileLogWriter) ModTime() time.Time {
+	_, modTime, err := statLog(w.filename)
+	if err == nil {
+		return modTime
+	}
+	return time.Time{}
+}
+
+func (w fileLogWriter) Sync() error {
+	return w.file.Sync()
+}
+
 func (w fileLogWriter) String() string {
-	b, err := ioutil.ReadFile(w.filename)
+	f, err := openLog(w.filename)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	b, err := ioutil.ReadAll(f)
 	if err == nil {
 		return string(b)
 	}
@@ -177,9 +592,9 @@ func (w fileLogWriter) String() string {
 }
 
 func (w fileLogWriter) Len() int64 {
-	s, err := os.Stat(w.filename)
+	size, _, err := statLog(w.filename)
 	if err == nil {
-		return s.Size()
+		return size
 	}
 	return 0
 }
@@ -197,10 +612,27 @@ func newNode(
 	if env == nil {
 		env = map[string]string{}
 	}
+	sources := make(map[string]string, len(env))
+	for name := range env {
+		sources[name] = envOverride
+	}
 	env = addDefaultVars(env)
+	for name := range env {
+		if _, ok := sources[name]; !ok {
+			sources[name] = envDefault
+		}
+	}
 
-	stdout = replaceVars(stdout, env)
-	stderr = replaceVars(stderr, env)
+	// RUN is expanded when each run is started (see startRun), so a RUN
+	// variable in the node's environment must not be substituted here.
+	nodeVars := make(map[string]string, len(env))
+	for k, v := range env {
+		if k != runVar {
+			nodeVars[k] = v
+		}
+	}
+	stdout = replaceVars(stdout, nodeVars)
+	stderr = replaceVars(stderr, nodeVars)
 
 	n := &node{
 		Name:     name,
@@ -208,11 +640,13 @@ func newNode(
 		Env:      env,
 		Runs:     make([]*nodeRun, 0),
 		Service:  service,
+		Backoff:  minRestartBackoff,
 		Stdout:   stdout,
 		Stderr:   stderr,
 		Attrs:    attributes,
 		Locality: locality,
 	}
+	n.EnvSources = sources
 
 	if n.Service {
 		n.start()
@@ -221,76 +655,539 @@ func newNode(
 	return n
 }
 
+// Active returns the node's running run, or nil if it isn't running.
+func (n *node) Active() *nodeRun {
+	n.startMu.Lock()
+	defer n.startMu.Unlock()
+	return n.active
+}
+
+// takeActive clears the node's active run, returning it.
+func (n *node) takeActive() *nodeRun {
+	n.startMu.Lock()
+	defer n.startMu.Unlock()
+	r := n.active
+	n.active = nil
+	return r
+}
+
 func (n *node) Command() string {
 	return strings.Join(n.Args, " ")
 }
 
 func (n *node) start() {
-	if n.Active != nil {
+	if n.Active() != nil {
 		return
 	}
 
-	run := len(n.Runs)
-
+	// Args are changed under startMu, e.g. by upgradeNode.
+	n.startMu.Lock()
 	args := append([]string(nil), n.Args...)
+	n.startMu.Unlock()
 	for i := range args {
 		args[i] = replaceVars(args[i], n.Env)
 	}
 
-	cmd := exec.Command(args[0], args[1:]...)
+	n.startRun(args, n.Env, n.start)
+}
+
+// startError returns why the node's last start didn't execute a process, or
+// nil if it did.
+func (n *node) startError() error {
+	switch {
+	case n.PinError != "":
+		return errors.New(n.PinError)
+	case n.HookError != "":
+		return errors.New(n.HookError)
+	case len(n.Runs) == 0:
+		return errors.New("not started")
+	}
+	return n.Runs[len(n.Runs)-1].Error
+}
+
+// rerun starts a new run using the exact args and environment of a previous
+// run. Automatic restarts of the new run reuse the same args and environment.
+func (n *node) rerun(prev *nodeRun) {
+	var restart func()
+	restart = func() {
+		n.startRun(prev.Args, prev.Env, restart)
+	}
+	restart()
+}
+
+// startRun starts a new run of the node using the specified (already
+// expanded) args and environment. If the node is a service, restart is
+// invoked when the run exits.
+func (n *node) startRun(args []string, env map[string]string, restart func()) {
+	n.startMu.Lock()
+	if n.starting || n.active != nil {
+		n.startMu.Unlock()
+		return
+	}
+	n.starting = true
+	n.startMu.Unlock()
+	defer func() {
+		n.startMu.Lock()
+		n.starting = false
+		n.startMu.Unlock()
+	}()
+
+	if err := n.checkPinnedBinary(args[0]); err != nil {
+		log.Printf("node %s: %s, not starting", n.Name, err)
+		n.PinError = err.Error()
+		return
+	}
+	n.PinError = ""
+
+	run := len(n.Runs)
+
+	// The previous run's post-stop hook completes before this run's
+	// pre-start hook starts.
+	if run > 0 {
+		if prev := n.Runs[run-1]; prev.hooked != nil {
+			<-prev.hooked
+		}
+	}
+	if err := n.runHook("pre-start", n.PreStartHook, run, env); err != nil {
+		log.Printf("node %s: %s, not starting", n.Name, err)
+		n.HookError = err.Error()
+		return
+	}
+	n.HookError = ""
+
+	var memLimit int64
+	if n.MemLimit != "" {
+		var err error
+		memLimit, err = parseByteSize(n.MemLimit)
+		if err == nil {
+			err = n.checkMemLimit()
+		}
+		if err != nil {
+			log.Printf("node %s: %s, starting without a memory limit", n.Name, err)
+			memLimit = 0
+		}
+	}
+
+	cpuSet := n.CPUSet
+	if cpuSet != "" {
+		if err := n.checkCPUSet(); err != nil {
+			log.Printf("node %s: %s, starting without pinning to cores", n.Name, err)
+			cpuSet = ""
+		}
+	}
+	numa := n.NUMANode
+	if numa != "" {
+		id, err := strconv.Atoi(numa)
+		if err == nil {
+			err = n.checkNUMA(id)
+		}
+		if err == nil && cpuSet != "" && n.Container == "" {
+			err = checkNUMACPUs(id, cpuSet)
+		}
+		if err != nil {
+			log.Printf("node %s: %s, starting without NUMA binding", n.Name, err)
+			numa = ""
+		}
+	}
+	runEnv := env
+	if n.CPUs > 0 && n.Container == "" {
+		runEnv = cpuEnv(env, n.CPUs)
+	}
+
+	cmdArgs := args
+	if memLimit > 0 && n.Container != "" {
+		cmdArgs = n.memLimitArgs(cmdArgs, memLimit)
+	}
+	if n.CPUs > 0 && n.Container != "" {
+		cmdArgs = dockerCPUArgs(cmdArgs, n.CPUs, cpuSet)
+	}
+	delay := n.SlowStart
+	if delay > 0 {
+		n.SlowStart = 0
+		cmdArgs = append([]string{
+			"/bin/sh", "-c", fmt.Sprintf(`sleep %g && exec "$@"`, delay.Seconds()), "sh",
+		}, cmdArgs...)
+	}
+	tracer := n.TraceNext
+	var tracePath string
+	if tracer != "" {
+		n.TraceNext = ""
+		tracePath = n.tracePath(run, tracer)
+		cmdArgs = traceArgs(cmdArgs, tracer, tracePath)
+	}
+	if cpuSet != "" && n.Container == "" {
+		cmdArgs = append([]string{"taskset", "-c", cpuSet}, cmdArgs...)
+	}
+	if numa != "" {
+		id, _ := strconv.Atoi(numa)
+		cmdArgs = numaArgs(cmdArgs, id)
+	}
+	if memLimit > 0 && n.Container == "" {
+		cmdArgs = n.memLimitArgs(cmdArgs, memLimit)
+	} else if *allowFaults && n.checkFaults() == nil {
+		if faultArgs, err := n.faultCgroupArgs(cmdArgs); err != nil {
+			log.Printf("node %s: %s, starting outside of its cgroup; its traffic can't be blocked", n.Name, err)
+		} else {
+			cmdArgs = faultArgs
+		}
+	}
+	cmd := exec.Command(cmdArgs[0], cmdArgs[1:]...)
 
 	vars := map[string]string{
-		"RUN": strconv.Itoa(run),
+		runVar: strconv.Itoa(run),
 	}
 	stdout := replaceVars(n.Stdout, vars)
 	stderr := replaceVars(n.Stderr, vars)
+	if *mergeOutput {
+		stderr = ""
+	}
 
-	n.Active = &nodeRun{
-		ID:     run,
-		Cmd:    cmd,
-		Args:   args,
-		Env:    n.Env,
-		Stdout: stdout,
-		Stderr: stderr,
+	r := &nodeRun{
+		ID:        run,
+		Cmd:       cmd,
+		Args:      args,
+		Env:       runEnv,
+		Stdout:    stdout,
+		Stderr:    stderr,
+		Merged:    *mergeOutput,
+		Container: n.Container,
+		Delay:     delay,
+		MemLimit:  memLimit,
+		hooked:    make(chan struct{}),
+	}
+	r.node = n.Name
+	r.Tracer = tracer
+	r.tracePath = tracePath
+	r.CPUs = n.CPUs
+	r.NUMANode = numa
+	if n.Container == "" {
+		r.Build = versions.binaryBuild(args[0])
+		if isRaceBinary(args[0]) {
+			r.Race = true
+			r.races = &raceDetector{}
+		}
 	}
-	n.Runs = append(n.Runs, n.Active)
+	n.startMu.Lock()
+	n.active = r
+	n.Runs = append(n.Runs, r)
+	n.startMu.Unlock()
+	statStarts.Add(1)
 
-	c := make(chan struct{})
-	n.Active.start(c)
+	// r.start signals the exit before returning if the process can't be
+	// executed.
+	c := make(chan struct{}, 1)
+	r.start(c)
 	go func() {
 		<-c
-		n.Active = nil
+		if err := n.runHook("post-stop", n.PostStopHook, r.ID, env); err != nil {
+			log.Printf("node %s: %s", n.Name, err)
+		}
+		close(r.hooked)
+
+		n.startMu.Lock()
+		if n.active == r && r.looksOOMKilled() {
+			r.OOMKilled = true
+			log.Printf("node %s run %d was killed by SIGKILL, possibly by the OOM killer", n.Name, r.ID)
+		}
+		if n.active == r && r.looksDaemonized() {
+			r.TooShort = true
+			n.RunTooShort = true
+			log.Printf("node %s run %d exited successfully after %s; the command may be daemonizing, "+
+				"make sure it runs in the foreground (e.g. without --background)",
+				n.Name, r.ID, r.Stopped.Sub(r.Started).Round(time.Millisecond))
+		} else {
+			n.RunTooShort = false
+		}
+		if n.active != r {
+			// The run was stopped intentionally (and possibly replaced by a
+			// new run) rather than exiting on its own.
+			n.startMu.Unlock()
+			return
+		}
+		n.active = nil
+		statCrashes.Add(1)
+		if dir, ok := n.lockedStore(r); ok {
+			// Restarting would only fail the same way until the other
+			// process lets go of the store.
+			r.StoreLocked = true
+			n.StoreLocked = dir
+			n.Service = false
+			n.startMu.Unlock()
+			log.Printf("node %s: store %s is locked by another process, not restarting", n.Name, dir)
+			return
+		}
 		if n.Service {
-			time.Sleep(time.Second * 1)
-			n.start()
+			n.recordExit(r)
+		}
+		service, backoff := n.Service, n.Backoff
+		n.startMu.Unlock()
+		if service {
+			time.Sleep(backoff)
+			statRestarts.Add(1)
+			restart()
 			return
 		}
 	}()
 }
 
+// recordExit updates the restart backoff state after the run exited
+// unexpectedly. A run which lasted at least stableRunDuration resets the
+// backoff; otherwise the backoff doubles and automatic restarts are disabled
+// after maxFailures consecutive failures.
+func (n *node) recordExit(r *nodeRun) {
+	if !r.Stopped.IsZero() && r.Stopped.Sub(r.Started) >= stableRunDuration {
+		n.resetBackoff()
+		return
+	}
+	n.Failures++
+	if n.Failures > 1 {
+		n.Backoff *= 2
+		if n.Backoff > maxRestartBackoff {
+			n.Backoff = maxRestartBackoff
+		}
+	}
+	if n.Failures >= maxFailures {
+		log.Printf("node %s failed %d times in a row, disabling restarts", n.Name, n.Failures)
+		n.Service = false
+		n.Disabled = true
+	}
+}
+
+// looksDaemonized returns whether the run's process exited successfully
+// within shortRunDuration. A command which daemonizes (forks and exits in the
+// parent) does this while the forked cockroach keeps running, which would
+// otherwise only show up as a restart loop.
+func (r *nodeRun) looksDaemonized() bool {
+	if r.Error != nil || r.Stopped.IsZero() {
+		return false
+	}
+	return r.WaitStatus.Exited() && r.WaitStatus.ExitStatus() == 0 &&
+		r.Stopped.Sub(r.Started) < shortRunDuration
+}
+
+func (n *node) resetBackoff() {
+	n.Failures = 0
+	n.Backoff = minRestartBackoff
+	n.Disabled = false
+}
+
 func (n *node) stop() {
-	if n.Active != nil {
-		n.Active.stop()
-		n.Active = nil
+	if r := n.takeActive(); r != nil {
+		r.stop()
+	}
+}
+
+// startService starts the node as a service, which is restarted when it
+// exits, clearing any disabling of automatic restarts.
+func (n *node) startService() {
+	n.startMu.Lock()
+	n.Service = true
+	n.Disabled = false
+	n.StoreLocked = ""
+	n.startMu.Unlock()
+	n.start()
+}
+
+// stopService stops the node, which is then not restarted.
+func (n *node) stopService() {
+	n.startMu.Lock()
+	n.Service = false
+	n.startMu.Unlock()
+	n.stop()
+}
+
+func (n *node) restart() {
+	n.stop()
+	n.start()
+}
+
+// gracefulStopTimeout is how long a graceful restart waits for the process
+// to exit before killing it.
+const gracefulStopTimeout = 30 * time.Second
+
+// gracefulRestart stops the active run with SIGTERM, waits for it to exit
+// and starts a new run.
+func (n *node) gracefulRestart() {
+	if r := n.takeActive(); r != nil {
+		timeout := gracefulStopTimeout
+		if r.Race {
+			timeout *= raceSlowdown
+		}
+		r.gracefulStop(timeout)
 	}
+	n.start()
+}
+
+// pruneBuffers drops the output buffers of the node's runs which stopped
+// more than retention ago, except the pinned run. Their output remains
+// available from the log files.
+func (n *node) pruneBuffers(retention time.Duration) {
+	active := n.Active()
+	for _, r := range n.Runs {
+		if r == active || r.Pinned || r.Stopped.IsZero() || time.Since(r.Stopped) < retention {
+			continue
+		}
+		r.StdoutBuf, r.StderrBuf = nil, nil
+	}
+}
+
+// LogsToFiles returns whether cockroach writes its own log files, i.e. it
+// isn't logging to stderr or with the log directory disabled.
+func (n *node) LogsToFiles() bool {
+	for _, arg := range n.Args {
+		switch arg {
+		case "--logtostderr", "--logtostderr=true", "--logtostderr=INFO", "--log-dir=", `--log-dir=""`:
+			return false
+		}
+	}
+	return true
+}
+
+// processAlive returns whether the process with the specified pid exists
+// and is not a zombie.
+func processAlive(pid int) bool {
+	if err := syscall.Kill(pid, 0); err != nil {
+		return false
+	}
+	b, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		// No procfs (e.g. macOS): ask ps for the state instead. If that
+		// fails too, signal 0 succeeding is the best we can do.
+		out, err := runCommand(0, nil, "ps", "-o", "stat=", "-p", strconv.Itoa(pid))
+		if err != nil {
+			return true
+		}
+		return !strings.HasPrefix(strings.TrimSpace(string(out)), "Z")
+	}
+	// The state follows the parenthesized command name.
+	s := string(b)
+	if i := strings.LastIndexByte(s, ')'); i >= 0 && i+2 < len(s) {
+		return s[i+2] != 'Z'
+	}
+	return true
+}
+
+// reap clears the active run if its process is no longer alive, recording
+// the run as stopped. It returns whether the run was reaped.
+func (n *node) reap() bool {
+	r := n.Active()
+	if r == nil || r.Cmd == nil || r.Cmd.Process == nil || processAlive(r.Cmd.Process.Pid) {
+		return false
+	}
+	n.startMu.Lock()
+	defer n.startMu.Unlock()
+	if n.active != r {
+		return false
+	}
+	if r.Stopped.IsZero() {
+		r.Stopped = time.Now()
+	}
+	n.active = nil
+	return true
+}
+
+// pin marks the specified run as the node's baseline, unpinning any
+// previously pinned run. Pinning an already pinned run unpins it.
+func (n *node) pin(r *nodeRun) {
+	pinned := !r.Pinned
+	for _, run := range n.Runs {
+		run.Pinned = false
+	}
+	r.Pinned = pinned
+}
+
+// SQLURL returns the connection string for the node's SQL port.
+func (n *node) SQLURL() string {
+	return fmt.Sprintf("postgresql://root@%s/defaultdb?sslmode=disable",
+		net.JoinHostPort(n.Host, strconv.Itoa(n.SQLPort)))
+}
+
+// ConnectCommand returns the command line for opening a SQL shell connected
+// to the node.
+func (n *node) ConnectCommand() string {
+	return fmt.Sprintf("cockroach sql --url='%s'", n.SQLURL())
+}
+
+// Restarts returns the number of times the node has been restarted.
+func (n *node) Restarts() int {
+	if len(n.Runs) == 0 {
+		return 0
+	}
+	return len(n.Runs) - 1
+}
+
+// DiskUsage returns the total size of the files in the node's data
+// directory. For in-memory stores this only includes the logs.
+func (n *node) DiskUsage() int64 {
+	var size int64
+	_ = filepath.Walk(n.Dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// HumanDiskUsage returns DiskUsage in human readable form.
+func (n *node) HumanDiskUsage() string {
+	return humanizeBytes(n.DiskUsage())
+}
+
+// Uptime returns how long the node's active run has been running, or 0 if
+// it isn't.
+func (n *node) Uptime() time.Duration {
+	r := n.Active()
+	if r == nil {
+		return 0
+	}
+	return time.Since(r.Started).Round(time.Second)
+}
+
+// Binary returns the cockroach binary the node runs.
+func (n *node) Binary() string {
+	return n.Args[0]
+}
+
+// setFlag replaces any occurrences of the specified flag in the node's args
+// with --name=value. An empty value removes the flag.
+func (n *node) setFlag(name, value string) {
+	n.Args = setFlag(n.Args, name, value)
+}
+
+func setFlag(args []string, name, value string) []string {
+	prefix := "--" + name + "="
+	var res []string
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, prefix) {
+			res = append(res, arg)
+		}
+	}
+	if value != "" {
+		res = append(res, prefix+value)
+	}
+	return res
 }
 
 func (n *node) pause() {
-	if n.Active != nil {
-		n.Active.pause()
+	if r := n.Active(); r != nil {
+		r.pause()
 	}
 }
 
 func (n *node) resume() {
-	if n.Active != nil {
-		n.Active.resume()
+	if r := n.Active(); r != nil {
+		r.resume()
 	}
 }
 
 func (n *node) Status() string {
-	if n.Active != nil && n.Active.Cmd != nil &&
-		n.Active.Cmd.Process != nil && n.Active.Cmd.Process.Pid > 0 {
-		if n.Active.Paused {
+	if r := n.Active(); r != nil && r.Cmd != nil &&
+		r.Cmd.Process != nil && r.Cmd.Process.Pid > 0 {
+		if r.Zombie {
+			return "Zombie"
+		}
+		if r.Paused {
 			return "Paused"
 		}
 		return "Running"
@@ -298,21 +1195,80 @@ func (n *node) Status() string {
 	return "Stopped"
 }
 
+// currentUser is user.Current, overridable so the fallback path can be
+// exercised.
+var currentUser = user.Current
+
+var userWarning sync.Once
+
+// fallbackUser returns a user built from the environment and process
+// credentials, for use when user.Current fails (e.g. in minimal containers
+// without /etc/passwd entries).
+func fallbackUser() *user.User {
+	u := &user.User{
+		Username: os.Getenv("USER"),
+		Uid:      strconv.Itoa(os.Getuid()),
+		Gid:      strconv.Itoa(os.Getgid()),
+		HomeDir:  os.Getenv("HOME"),
+	}
+	if u.Username == "" {
+		u.Username = "nobody"
+	}
+	if u.HomeDir == "" {
+		u.HomeDir = "/"
+	}
+	return u
+}
+
+// The sources of a node's environment variables.
+const (
+	// envInherited variables were copied from roachdemo's environment.
+	envInherited = "inherited"
+	// envDefault variables were added by addDefaultVars.
+	envDefault = "default"
+	// envOverride variables were specified for the node, e.g. by an
+	// imported cluster state.
+	envOverride = "per-node"
+)
+
+type envVar struct {
+	Name   string
+	Value  string
+	Source string
+}
+
+// EnvVars returns the node's environment variables, sorted by name, along
+// with where each came from.
+func (n *node) EnvVars() []envVar {
+	vars := make([]envVar, 0, len(n.Env))
+	for name, value := range n.Env {
+		vars = append(vars, envVar{Name: name, Value: value, Source: n.EnvSources[name]})
+	}
+	sort.Slice(vars, func(i, j int) bool {
+		return vars[i].Name < vars[j].Name
+	})
+	return vars
+}
+
 func addDefaultVars(vars map[string]string) map[string]string {
-	u, err := user.Current()
-	if err == nil {
-		if _, ok := vars["USER"]; !ok {
-			vars["USER"] = u.Username
-		}
-		if _, ok := vars["UID"]; !ok {
-			vars["UID"] = u.Uid
-		}
-		if _, ok := vars["GID"]; !ok {
-			vars["GID"] = u.Gid
-		}
-		if _, ok := vars["HOME"]; !ok {
-			vars["HOME"] = u.HomeDir
-		}
+	u, err := currentUser()
+	if err != nil {
+		userWarning.Do(func() {
+			log.Printf("unable to determine current user, falling back to environment: %s", err)
+		})
+		u = fallbackUser()
+	}
+	if _, ok := vars["USER"]; !ok {
+		vars["USER"] = u.Username
+	}
+	if _, ok := vars["UID"]; !ok {
+		vars["UID"] = u.Uid
+	}
+	if _, ok := vars["GID"]; !ok {
+		vars["GID"] = u.Gid
+	}
+	if _, ok := vars["HOME"]; !ok {
+		vars["HOME"] = u.HomeDir
 	}
 	if _, ok := vars["PATH"]; !ok {
 		vars["PATH"] = os.Getenv("PATH")
@@ -320,6 +1276,10 @@ func addDefaultVars(vars map[string]string) map[string]string {
 	return vars
 }
 
+// runVar is the variable the stdout and stderr paths of a node are expanded
+// with to the ID of the run being started.
+const runVar = "RUN"
+
 func replaceVars(text string, vars map[string]string) string {
 	return os.Expand(text, func(name string) string {
 		v, ok := vars[name]
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// waitRun waits for the run to exit and for its exit to be handled.
+func waitRun(t *testing.T, r *nodeRun) {
+	t.Helper()
+	select {
+	case <-r.hooked:
+	case <-time.After(10 * time.Second):
+		t.Fatalf("run %d did not exit", r.ID)
+	}
+}
+
+func TestStartWithoutLogFiles(t *testing.T) {
+	testCases := []struct {
+		name   string
+		args   []string
+		failed bool
+	}{
+		{"exits", []string{"/bin/sh", "-c", "echo out; echo err >&2"}, false},
+		{"exec fails", []string{"/nonexistent/cockroach", "start"}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := newNode("1", tc.args, nil, false, "", "", "", "")
+			n.start()
+			if len(n.Runs) != 1 {
+				t.Fatalf("expected 1 run, got %d", len(n.Runs))
+			}
+			r := n.Runs[0]
+			waitRun(t, r)
+			if r.StdoutBuf != nil || r.StderrBuf != nil {
+				t.Fatalf("expected no log writers")
+			}
+			if failed := r.Error != nil; failed != tc.failed {
+				t.Fatalf("expected failed=%t, got error %v", tc.failed, r.Error)
+			}
+		})
+	}
+}
+
+func TestAddDefaultVarsWithoutUser(t *testing.T) {
+	defer func(f func() (*user.User, error)) { currentUser = f }(currentUser)
+	currentUser = func() (*user.User, error) {
+		return nil, errors.New("user: unknown userid")
+	}
+	setenv := func(name, value string) {
+		old, ok := os.LookupEnv(name)
+		if value == "" {
+			os.Unsetenv(name)
+		} else {
+			os.Setenv(name, value)
+		}
+		t.Cleanup(func() {
+			if ok {
+				os.Setenv(name, old)
+			} else {
+				os.Unsetenv(name)
+			}
+		})
+	}
+
+	testCases := []struct {
+		envUser, envHome string
+		vars             map[string]string
+		user, home       string
+	}{
+		{"alice", "/home/alice", map[string]string{}, "alice", "/home/alice"},
+		{"", "", map[string]string{}, "nobody", "/"},
+		{"alice", "/home/alice", map[string]string{"HOME": "/data"}, "alice", "/data"},
+	}
+	for _, tc := range testCases {
+		setenv("USER", tc.envUser)
+		setenv("HOME", tc.envHome)
+		vars := addDefaultVars(tc.vars)
+		if vars["USER"] != tc.user || vars["HOME"] != tc.home {
+			t.Errorf("USER=%q HOME=%q: expected %s and %s, got %s and %s",
+				tc.envUser, tc.envHome, tc.user, tc.home, vars["USER"], vars["HOME"])
+		}
+		if vars["UID"] != strconv.Itoa(os.Getuid()) {
+			t.Errorf("expected UID %d, got %s", os.Getuid(), vars["UID"])
+		}
+		if got := replaceVars("${HOME}/cockroach-data", vars); got != tc.home+"/cockroach-data" {
+			t.Errorf("unexpected expansion %q", got)
+		}
+	}
+}
+
+func TestProcessAlive(t *testing.T) {
+	cmd := exec.Command("/bin/sh", "-c", "read line")
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	pid := cmd.Process.Pid
+	if !processAlive(pid) {
+		t.Fatalf("expected running process %d to be alive", pid)
+	}
+
+	// Until it is waited for, the exited process is a zombie.
+	stdin.Close()
+	deadline := time.Now().Add(10 * time.Second)
+	for processAlive(pid) {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected exited process %d not to be alive", pid)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := syscall.Kill(pid, 0); err != nil {
+		t.Fatalf("expected zombie process %d to still exist: %s", pid, err)
+	}
+
+	_ = cmd.Wait()
+	if processAlive(pid) {
+		t.Fatalf("expected reaped process %d not to be alive", pid)
+	}
+}
+
+func TestNotesSurviveRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-notes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	n := newNode("1", []string{"/bin/sh", "-c", "exit 0"}, nil, false, "", "", "", "")
+	n.Dir = dir
+	n.start()
+	waitRun(t, n.Runs[0])
+	if err := n.setNotes(0, "crashed here after ALTER"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A later roachdemo process starts the node's runs from 0 again.
+	n = newNode("1", []string{"/bin/sh", "-c", "exit 0"}, nil, false, "", "", "", "")
+	n.Dir = dir
+	if err := n.loadNotes(); err != nil {
+		t.Fatal(err)
+	}
+	n.start()
+	waitRun(t, n.Runs[0])
+	if got := n.RunNotes(0); got != "crashed here after ALTER" {
+		t.Fatalf("expected the notes to survive, got %q", got)
+	}
+
+	if err := n.setNotes(0, ""); err != nil {
+		t.Fatal(err)
+	}
+	n.Notes = nil
+	if err := n.loadNotes(); err != nil {
+		t.Fatal(err)
+	}
+	if len(n.Notes) != 0 {
+		t.Fatalf("expected cleared notes to be removed, got %v", n.Notes)
+	}
+}
+
+func TestPruneBuffersSkipsPinned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-prune")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	n := newNode("1", nil, nil, false, "", "", "", "")
+	stopped := time.Now().Add(-time.Hour)
+	for i := 0; i < 2; i++ {
+		w, err := newFileLogWriter(filepath.Join(dir, strconv.Itoa(i)+".stdout"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer w.Close()
+		n.Runs = append(n.Runs, &nodeRun{ID: i, Stopped: stopped, StdoutBuf: w})
+	}
+	n.pin(n.Runs[1])
+
+	n.pruneBuffers(time.Minute)
+	if n.Runs[0].StdoutBuf != nil {
+		t.Errorf("expected the buffer of the unpinned run to be pruned")
+	}
+	if n.Runs[1].StdoutBuf == nil {
+		t.Errorf("expected the buffer of the pinned run to be kept")
+	}
+}
+
+func TestRunNotExpandedFromEnv(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	env := map[string]string{"RUN": "oops", "PORT": "26300"}
+	n := newNode("1", []string{"/bin/sh", "-c", `echo "$0"`, "--port=${PORT}"}, env, false,
+		filepath.Join(dir, "${RUN}.stdout"), filepath.Join(dir, "${RUN}.stderr"), "", "")
+	for i := 0; i < 2; i++ {
+		n.start()
+		waitRun(t, n.Runs[i])
+	}
+
+	for i, r := range n.Runs {
+		if want := filepath.Join(dir, strconv.Itoa(i)+".stdout"); r.Stdout != want {
+			t.Errorf("run %d: expected stdout %s, got %s", i, want, r.Stdout)
+		}
+		if want := filepath.Join(dir, strconv.Itoa(i)+".stderr"); r.Stderr != want {
+			t.Errorf("run %d: expected stderr %s, got %s", i, want, r.Stderr)
+		}
+		b, err := ioutil.ReadFile(r.Stdout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Variables other than RUN are still expanded in the args.
+		if got := string(b); got != "--port=26300\n" {
+			t.Errorf("run %d: expected the port flag to be expanded, got %q", i, got)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "oops.stdout")); !os.IsNotExist(err) {
+		t.Errorf("expected no log named after the RUN variable")
+	}
+}
+
+func TestOOMKilledRequiresMemLimit(t *testing.T) {
+	killed := syscall.WaitStatus(syscall.SIGKILL)
+	if !killed.Signaled() {
+		t.Skip("unexpected wait status encoding")
+	}
+	r := &nodeRun{WaitStatus: killed}
+	if r.looksOOMKilled() {
+		t.Fatalf("expected a SIGKILL without a memory limit not to look like the OOM killer")
+	}
+	r.MemLimit = 1 << 30
+	if !r.looksOOMKilled() {
+		t.Fatalf("expected a SIGKILL with a memory limit to look like the OOM killer")
+	}
+}
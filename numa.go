@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// numaNodeDir is where Linux lists the host's NUMA nodes, as node0, node1,
+// etc.
+const numaNodeDir = "/sys/devices/system/node"
+
+// numaNodes returns the IDs of the host's NUMA nodes, sorted.
+func numaNodes() ([]int, error) {
+	infos, err := ioutil.ReadDir(numaNodeDir)
+	if err != nil {
+		return nil, err
+	}
+	var ids []int
+	for _, info := range infos {
+		if !info.IsDir() || !strings.HasPrefix(info.Name(), "node") {
+			continue
+		}
+		if id, err := strconv.Atoi(strings.TrimPrefix(info.Name(), "node")); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// checkNUMA returns an error if the node's runs can't be bound to the NUMA
+// node id. Runs are bound with numactl, which requires Linux.
+func (n *node) checkNUMA(id int) error {
+	if n.Container != "" {
+		return fmt.Errorf("node %s runs in a container and can't be bound to a NUMA node", n.Name)
+	}
+	if runtime.GOOS != "linux" {
+		return fmt.Errorf("NUMA binding requires Linux, not %s", runtime.GOOS)
+	}
+	if _, err := exec.LookPath("numactl"); err != nil {
+		return fmt.Errorf("NUMA binding requires numactl: %s", err)
+	}
+	ids, err := numaNodes()
+	if err != nil {
+		return fmt.Errorf("NUMA isn't available: %s", err)
+	}
+	for _, i := range ids {
+		if i == id {
+			return nil
+		}
+	}
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.Itoa(id)
+	}
+	return fmt.Errorf("NUMA node %d doesn't exist; the host's NUMA nodes are %s", id, strings.Join(s, ", "))
+}
+
+// numaNodeCPUs returns the set of the CPUs of the NUMA node id.
+func numaNodeCPUs(id int) (map[int]bool, error) {
+	b, err := ioutil.ReadFile(filepath.Join(numaNodeDir, fmt.Sprintf("node%d", id), "cpulist"))
+	if err != nil {
+		return nil, err
+	}
+	return parseCPUList(strings.TrimSpace(string(b)))
+}
+
+// checkNUMACPUs returns an error if the cores of cpuSet, which the node's
+// runs are pinned to with taskset, aren't all CPUs of the NUMA node id.
+// taskset would otherwise move the runs off the NUMA node while numactl
+// binds their memory to it.
+func checkNUMACPUs(id int, cpuSet string) error {
+	cpus, err := numaNodeCPUs(id)
+	if err != nil {
+		return fmt.Errorf("unable to list the CPUs of NUMA node %d: %s", id, err)
+	}
+	cores, err := parseCPUList(cpuSet)
+	if err != nil {
+		return err
+	}
+	var outside []int
+	for core := range cores {
+		if !cpus[core] {
+			outside = append(outside, core)
+		}
+	}
+	if len(outside) == 0 {
+		return nil
+	}
+	sort.Ints(outside)
+	s := make([]string, len(outside))
+	for i, core := range outside {
+		s[i] = strconv.Itoa(core)
+	}
+	return fmt.Errorf("cores %s conflict with NUMA node %d: cores %s aren't on it", cpuSet, id, strings.Join(s, ","))
+}
+
+// numaArgs returns args modified to run the process on the CPUs of the NUMA
+// node id, allocating memory only from that node.
+func numaArgs(args []string, id int) []string {
+	return append([]string{
+		"numactl", fmt.Sprintf("--cpunodebind=%d", id), fmt.Sprintf("--membind=%d", id),
+	}, args...)
+}
+
+// setNUMA binds the node's runs to the NUMA node given by the "node" form
+// value, restarting the node if it is running. An empty value removes the
+// binding.
+func (c *cluster) setNUMA(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	numa := strings.TrimSpace(req.FormValue("node"))
+	if numa != "" {
+		id, err := strconv.Atoi(numa)
+		if err != nil || id < 0 {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, fmt.Sprintf("invalid NUMA node %q", numa))
+			return
+		}
+		if err := t.checkNUMA(id); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, err.Error())
+			return
+		}
+		if t.CPUSet != "" {
+			if err := checkNUMACPUs(id, t.CPUSet); err != nil {
+				rw.WriteHeader(http.StatusBadRequest)
+				renderError(rw, err.Error())
+				return
+			}
+		}
+		numa = strconv.Itoa(id)
+	}
+
+	if numa != t.NUMANode {
+		if numa == "" {
+			c.events.add(t.Name, "NUMA binding removed")
+		} else {
+			c.events.add(t.Name, "bound to NUMA node %s", numa)
+		}
+		t.NUMANode = numa
+		if t.Active() != nil {
+			t.gracefulRestart()
+		}
+	}
+
+	redirect(rw, req)
+}
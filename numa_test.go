@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseCPUList(t *testing.T) {
+	testCases := []struct {
+		list string
+		cpus map[int]bool
+		err  bool
+	}{
+		{"", map[int]bool{}, false},
+		{"0-3,6", map[int]bool{0: true, 1: true, 2: true, 3: true, 6: true}, false},
+		{"5", map[int]bool{5: true}, false},
+		{"3-1", nil, true},
+		{"a-b", nil, true},
+	}
+	for _, tc := range testCases {
+		cpus, err := parseCPUList(tc.list)
+		if (err != nil) != tc.err || !reflect.DeepEqual(cpus, tc.cpus) {
+			t.Errorf("%q: expected %v (error %t), got %v, %v", tc.list, tc.cpus, tc.err, cpus, err)
+		}
+	}
+}
+
+func TestNUMACPUsConflict(t *testing.T) {
+	cpus, err := numaNodeCPUs(0)
+	if err != nil || len(cpus) == 0 {
+		t.Skipf("NUMA node 0 has no CPUs listed: %v", err)
+	}
+	var on, off int
+	for cpu := range cpus {
+		on = cpu
+		if cpu >= off {
+			off = cpu + 1
+		}
+	}
+	if err := checkNUMACPUs(0, strconv.Itoa(on)); err != nil {
+		t.Fatalf("expected core %d of NUMA node 0 not to conflict: %s", on, err)
+	}
+	if err := checkNUMACPUs(0, strconv.Itoa(off)); err == nil {
+		t.Fatalf("expected core %d, off NUMA node 0, to conflict", off)
+	}
+
+	// Pinning a node bound to NUMA node 0 to the other core is refused.
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	n := newNode("1", []string{"/bin/true"}, nil, false, "", "", "", "")
+	n.NUMANode = "0"
+	c.Nodes[n.Name] = n
+	if err := n.checkCPUSet(); err != nil {
+		t.Skip(err)
+	}
+	form := url.Values{"n": {"1"}, "cores": {strconv.Itoa(off)}}
+	req := httptest.NewRequest("POST", "/node/1/cpus", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rw := httptest.NewRecorder()
+	c.setCPUs(rw, req, map[string]string{"node": "1"})
+	if rw.Code != http.StatusBadRequest || !strings.Contains(rw.Body.String(), "conflict") {
+		t.Fatalf("expected the conflicting cores to be refused, got %d: %s", rw.Code, rw.Body)
+	}
+	if n.CPUSet != "" {
+		t.Fatalf("expected the node not to be pinned, got cores %s", n.CPUSet)
+	}
+}
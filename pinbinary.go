@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+)
+
+// resolveBinary returns the path bin is executed from, as found by
+// exec.LookPath, or bin itself if it can't be found.
+func resolveBinary(bin string) string {
+	if path, err := exec.LookPath(bin); err == nil {
+		return path
+	}
+	return bin
+}
+
+// sameBinary returns whether a and b execute the same binary, e.g. a name
+// found on PATH and its full path.
+func sameBinary(a, b string) bool {
+	return resolveBinary(a) == resolveBinary(b)
+}
+
+// checkPinnedBinary returns an error if the node is pinned to a binary and
+// bin is a different binary, or the pinned binary was replaced by another
+// version since it was pinned.
+func (n *node) checkPinnedBinary(bin string) error {
+	if n.PinnedBinary == "" {
+		return nil
+	}
+	if !sameBinary(bin, n.PinnedBinary) {
+		return fmt.Errorf("node %s is pinned to %s (%s), not %s; unpin it first",
+			n.Name, n.PinnedBinary, n.PinnedVersion, bin)
+	}
+	version, err := cockroachVersion(bin)
+	if err != nil {
+		return fmt.Errorf("unable to determine version of pinned binary %s: %s", bin, err)
+	}
+	if version != n.PinnedVersion {
+		return fmt.Errorf("node %s is pinned to %s but %s is now %s; unpin it first",
+			n.Name, n.PinnedVersion, bin, version)
+	}
+	return nil
+}
+
+// pinBinary pins the node to its current binary, or unpins it if the "unpin"
+// form value is true. A pinned node refuses to start with any other binary,
+// or with its binary replaced by another version.
+func (c *cluster) pinBinary(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	if req.FormValue("unpin") == "true" {
+		if t.PinnedBinary != "" {
+			c.events.add(t.Name, "unpinned from %s (%s)", t.PinnedBinary, t.PinnedVersion)
+		}
+		t.PinnedBinary, t.PinnedVersion, t.PinError = "", "", ""
+		redirect(rw, req)
+		return
+	}
+
+	if t.Container != "" {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("node %s runs in docker and has no binary to pin", t.Name))
+		return
+	}
+	version, err := cockroachVersion(t.Binary())
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("unable to determine version of %s: %s", t.Binary(), err))
+		return
+	}
+	t.PinnedBinary, t.PinnedVersion = t.Binary(), version
+	c.events.add(t.Name, "pinned to %s (%s)", t.PinnedBinary, t.PinnedVersion)
+
+	redirect(rw, req)
+}
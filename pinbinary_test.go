@@ -0,0 +1,47 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUpgradePinnedResolved(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-pin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pinned := writeFakeCockroach(t, dir, "cockroach-pinned", "v1.0.0")
+	other := writeFakeCockroach(t, dir, "cockroach-other", "v2.0.0")
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir+string(filepath.ListSeparator)+os.Getenv("PATH"))
+
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	n := newNode("1", []string{"cockroach-pinned", "start"}, nil, false, "", "", "", "")
+	n.PinnedBinary, n.PinnedVersion = "cockroach-pinned", "v1.0.0"
+	c.Nodes[n.Name] = n
+
+	testCases := []struct {
+		bin  string
+		code int
+	}{
+		{"cockroach-other", http.StatusConflict},
+		{other, http.StatusConflict},
+		{pinned, http.StatusFound},
+	}
+	for _, tc := range testCases {
+		rw := httptest.NewRecorder()
+		c.upgradeNode(rw, httptest.NewRequest("POST", "/node/1/upgrade?bin="+tc.bin, nil),
+			map[string]string{"node": "1"})
+		if rw.Code != tc.code {
+			t.Errorf("%s: expected %d, got %d: %s", tc.bin, tc.code, rw.Code, rw.Body)
+		}
+	}
+	if err := n.checkPinnedBinary(n.Binary()); err != nil {
+		t.Fatalf("expected the resolved pinned binary to be allowed to start: %s", err)
+	}
+}
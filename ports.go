@@ -0,0 +1,106 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// portFlags are the cockroach flags which specify a port the process listens
+// on, either directly or as part of an address.
+var portFlags = []string{"--port", "--http-port", "--listen-addr", "--sql-addr", "--http-addr"}
+
+type portUse struct {
+	// Owner is "node" or "tenant" and Name is the name of the owning node.
+	Owner string
+	Name  string
+	Flag  string
+	Host  string
+	Port  int
+	// Collision is set if another process uses the same port.
+	Collision bool
+}
+
+// argPorts returns the ports specified by the port flags in args. A flag's
+// value may be given either as --flag=value or as the following argument.
+// The last occurrence of a flag wins, matching cockroach's flag parsing.
+func argPorts(args []string) []portUse {
+	// --host is the host --port listens on.
+	flags := append([]string{"--host"}, portFlags...)
+	values := map[string]string{}
+	for i, arg := range args {
+		for _, flag := range flags {
+			switch {
+			case strings.HasPrefix(arg, flag+"="):
+				values[flag] = strings.TrimPrefix(arg, flag+"=")
+			case arg == flag && i+1 < len(args):
+				values[flag] = args[i+1]
+			}
+		}
+	}
+
+	var ports []portUse
+	for _, flag := range portFlags {
+		value, ok := values[flag]
+		if !ok {
+			continue
+		}
+		var host string
+		if flag == "--port" {
+			host = values["--host"]
+		}
+		if strings.HasSuffix(flag, "-addr") {
+			var err error
+			host, value, err = net.SplitHostPort(value)
+			if err != nil {
+				continue
+			}
+		}
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			continue
+		}
+		ports = append(ports, portUse{Flag: flag, Host: host, Port: port})
+	}
+	return ports
+}
+
+// portMap returns the ports used by all nodes and tenants, sorted by port,
+// flagging any port used more than once.
+func (c *cluster) portMap() []portUse {
+	var ports []portUse
+	add := func(owner string, nodes []*node) {
+		for _, t := range nodes {
+			for _, p := range argPorts(t.Args) {
+				p.Owner, p.Name = owner, t.Name
+				ports = append(ports, p)
+			}
+		}
+	}
+	add("node", c.sortedNodes())
+	add("tenant", c.sortedTenants())
+
+	uses := map[int]int{}
+	for _, p := range ports {
+		uses[p.Port]++
+	}
+	for i := range ports {
+		ports[i].Collision = uses[ports[i].Port] > 1
+	}
+	sort.SliceStable(ports, func(i, j int) bool {
+		return ports[i].Port < ports[j].Port
+	})
+	return ports
+}
+
+func (c *cluster) showPorts(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	data := map[string]interface{}{
+		"Title":   "ports",
+		"Page":    "Ports",
+		"Cluster": c,
+		"Ports":   c.portMap(),
+	}
+	renderLayout(rw, req, "ports.html", "layout.html", "Content", data)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// pprofTimeout bounds how long fetching a profile from a node may take. CPU
+// profiles take 30 seconds by default.
+const pprofTimeout = 2 * time.Minute
+
+// nodePprof proxies the node's /debug/pprof/<profile> endpoint, returning the
+// profile as a download. The query string is passed through so that, for
+// example, ?seconds=10 shortens a CPU profile.
+func (c *cluster) nodePprof(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	if t.Status() != "Running" {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		renderError(rw, fmt.Sprintf("node %s is %s", t.Name, t.Status()))
+		return
+	}
+
+	profile := args["profile"]
+	// The fetch is tied to the request so that it is abandoned, rather than
+	// left to run for up to pprofTimeout, if the client goes away.
+	resp, err := t.fetchPprof(req.Context(), profile, req.URL.RawQuery)
+	if err != nil {
+		rw.WriteHeader(http.StatusBadGateway)
+		renderError(rw, err.Error())
+		return
+	}
+	defer resp.Body.Close()
+
+	rw.Header().Set("Content-Type", resp.Header.Get("Content-Type"))
+	rw.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"node-%s-%s.pprof\"", t.Name, profile))
+	if err := copyStream(rw, req, resp.Body); err != nil {
+		log.Print(err)
+	}
+}
+
+// fetchPprof requests the node's /debug/pprof/<profile> endpoint with the
+// specified query string, returning the response if it succeeded. The caller
+// must close the response's body.
+func (n *node) fetchPprof(ctx context.Context, profile, query string) (*http.Response, error) {
+	url := fmt.Sprintf("%s/debug/pprof/%s", n.URL, profile)
+	if query != "" {
+		url += "?" + query
+	}
+	preq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := http.Client{Timeout: pprofTimeout}
+	resp, err := client.Do(preq)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %s profile from node %s: %s", profile, n.Name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unable to fetch %s profile from node %s: %s", profile, n.Name, resp.Status)
+	}
+	return resp, nil
+}
+
+// stacksTimeout bounds how long fetching the goroutine stacks of each node
+// may take when dumping the stacks of the whole cluster.
+const stacksTimeout = 30 * time.Second
+
+// stacksZip fetches the goroutine stacks of all nodes concurrently and
+// returns them as a zip archive of node-<id>-goroutines.txt files. Nodes
+// which aren't running or can't be reached get a file noting why in place
+// of their stacks.
+func (c *cluster) stacksZip(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	nodes := c.sortedNodes()
+	stacks := make([][]byte, len(nodes))
+	var wg sync.WaitGroup
+	for i, t := range nodes {
+		if t.Status() != "Running" {
+			stacks[i] = []byte(fmt.Sprintf("node %s is %s: unreachable\n", t.Name, t.Status()))
+			continue
+		}
+		wg.Add(1)
+		go func(i int, t *node) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(req.Context(), stacksTimeout)
+			defer cancel()
+			resp, err := t.fetchPprof(ctx, "goroutine", "debug=2")
+			if err == nil {
+				stacks[i], err = ioutil.ReadAll(resp.Body)
+				resp.Body.Close()
+			}
+			if err != nil {
+				stacks[i] = []byte(fmt.Sprintf("node %s is unreachable: %s\n", t.Name, err))
+			}
+		}(i, t)
+	}
+	wg.Wait()
+
+	rw.Header().Set("Content-Type", "application/zip")
+	rw.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"stacks-%s.zip\"", time.Now().Format("20060102-150405")))
+	zw := zip.NewWriter(rw)
+	for i, t := range nodes {
+		w, err := zw.Create(fmt.Sprintf("node-%s-goroutines.txt", t.Name))
+		if err == nil {
+			_, err = w.Write(stacks[i])
+		}
+		if err != nil {
+			log.Print(err)
+			return
+		}
+	}
+	if err := zw.Close(); err != nil {
+		log.Print(err)
+	}
+}
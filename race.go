@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// raceSlowdown is how many times longer the timeouts of runs of the
+	// -race-binary are: race-enabled builds run several times slower.
+	raceSlowdown = 5
+	// maxRaceReport bounds the first data race report kept for the run page.
+	maxRaceReport = 16 << 10
+	// maxRaceLine bounds the part of each line of output inspected for data
+	// race reports.
+	maxRaceLine = 256
+)
+
+// isRaceBinary returns whether bin is the -race-binary, comparing the paths
+// they resolve to.
+func isRaceBinary(bin string) bool {
+	if *raceBinary == "" {
+		return false
+	}
+	resolve := func(path string) string {
+		if p, err := exec.LookPath(path); err == nil {
+			path = p
+		}
+		if p, err := filepath.Abs(path); err == nil {
+			path = p
+		}
+		return path
+	}
+	return resolve(bin) == resolve(*raceBinary)
+}
+
+// runTimeout returns timeout scaled for the node's active run: raceSlowdown
+// times longer if it runs the race binary.
+func (n *node) runTimeout(timeout time.Duration) time.Duration {
+	if r := n.Active(); r != nil && r.Race {
+		return timeout * raceSlowdown
+	}
+	return timeout
+}
+
+// raceDetector scans output for the reports of the Go race detector, which
+// are delimited by lines of = and start with "WARNING: DATA RACE". It counts
+// the reports and keeps the first.
+type raceDetector struct {
+	mu    sync.Mutex
+	count int
+	first bytes.Buffer
+	// line is the start of the current line, up to maxRaceLine bytes, and
+	// inFirst is set while the first report is being captured.
+	line    []byte
+	inFirst bool
+}
+
+func (d *raceDetector) feed(p []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for len(p) > 0 {
+		eol := bytes.IndexByte(p, '\n')
+		chunk := p
+		if eol >= 0 {
+			chunk = p[:eol+1]
+		}
+		p = p[len(chunk):]
+		if d.inFirst && d.first.Len()+len(chunk) <= maxRaceReport {
+			d.first.Write(chunk)
+		}
+		if n := maxRaceLine - len(d.line); n > 0 {
+			if n > len(chunk) {
+				n = len(chunk)
+			}
+			d.line = append(d.line, chunk[:n]...)
+		}
+		if eol < 0 {
+			return
+		}
+		line := bytes.TrimSpace(d.line)
+		d.line = d.line[:0]
+		switch {
+		case bytes.Equal(line, []byte("WARNING: DATA RACE")):
+			d.count++
+			if d.count == 1 {
+				d.inFirst = true
+				d.first.WriteString("==================\n")
+				d.first.Write(chunk)
+			}
+		case d.inFirst && len(line) > 0 && len(bytes.Trim(line, "=")) == 0:
+			d.inFirst = false
+		}
+	}
+}
+
+// raceLogWriter is a logWriter which feeds the output written to it to a
+// raceDetector, in addition to writing it to the wrapped writer.
+type raceLogWriter struct {
+	logWriter
+	races *raceDetector
+}
+
+func (w raceLogWriter) Write(p []byte) (int, error) {
+	n, err := w.logWriter.Write(p)
+	w.races.feed(p[:n])
+	return n, err
+}
+
+// DataRaces returns the number of data races reported by the run, which is
+// only tracked for runs of the race binary.
+func (r *nodeRun) DataRaces() int {
+	if r.races == nil {
+		return 0
+	}
+	r.races.mu.Lock()
+	defer r.races.mu.Unlock()
+	return r.races.count
+}
+
+// FirstDataRace returns the first data race report of the run, if any.
+func (r *nodeRun) FirstDataRace() string {
+	if r.races == nil {
+		return ""
+	}
+	r.races.mu.Lock()
+	defer r.races.mu.Unlock()
+	return r.races.first.String()
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+// isStarting returns whether a run of the node is being started.
+func (n *node) isStarting() bool {
+	n.startMu.Lock()
+	defer n.startMu.Unlock()
+	return n.starting
+}
+
+// needsStart returns whether the node is intended to be running but isn't,
+// and its restart backoff has elapsed. A grace period of one interval on top
+// of the backoff leaves the restart of an exited run to the run's own exit
+// goroutine, which sleeps for the backoff before restarting it.
+func (n *node) needsStart(now time.Time, grace time.Duration) bool {
+	if !n.Service || n.Disabled || n.Active() != nil || n.isStarting() {
+		return false
+	}
+	if len(n.Runs) > 0 {
+		last := n.Runs[len(n.Runs)-1]
+		if !last.Stopped.IsZero() && now.Sub(last.Stopped) < n.Backoff+grace {
+			return false
+		}
+	}
+	return true
+}
+
+// reconciler periodically starts the nodes which are services but aren't
+// running, e.g. because their run was reaped, their pre-start hook failed or
+// their restart was lost. It is a safety net for the restart performed by
+// each run's exit goroutine, with which it is serialized by the node's
+// starting guard.
+func (c *cluster) reconciler(interval time.Duration) {
+	for now := range time.Tick(interval) {
+		for _, t := range c.sortedNodes() {
+			if !t.needsStart(now, interval) {
+				continue
+			}
+			log.Printf("node %s should be running, starting it", t.Name)
+			c.events.add(t.Name, "reconciler started node which should be running")
+			statReconcileStarts.Add(1)
+			go t.start()
+		}
+	}
+}
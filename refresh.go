@@ -0,0 +1,42 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// refreshCookie is the cookie holding the interval, in seconds, at which
+// pages are automatically refreshed.
+const refreshCookie = "refresh"
+
+// refreshIntervals are the auto-refresh intervals offered, in seconds, 0
+// being off.
+var refreshIntervals = []int{0, 2, 5, 10}
+
+// refreshPages are the pages which are automatically refreshed: passive
+// status pages which hold no forms or output that a reload would lose or
+// re-request.
+var refreshPages = map[string]bool{
+	"Nodes":  true,
+	"Events": true,
+	"Ports":  true,
+}
+
+// refreshInterval returns the auto-refresh interval chosen by the client, or
+// 0 if auto-refresh is off.
+func refreshInterval(req *http.Request) int {
+	c, err := req.Cookie(refreshCookie)
+	if err != nil {
+		return 0
+	}
+	n, err := strconv.Atoi(c.Value)
+	if err != nil {
+		return 0
+	}
+	for _, interval := range refreshIntervals {
+		if n == interval {
+			return n
+		}
+	}
+	return 0
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// recordedAction is a cluster action recorded with -record, one per line
+// of the recording, and re-executed by /replay. At is the time of the action
+// relative to the start of the recording, e.g. "1m30s".
+type recordedAction struct {
+	At     string `json:"at"`
+	Action string `json:"action"`
+	Node   string `json:"node,omitempty"`
+	// Count is the number of nodes added by "add", one if it is 0.
+	Count int `json:"count,omitempty"`
+
+	at time.Duration
+}
+
+// replayActions are the recordable actions, and whether each applies to a
+// node.
+var replayActions = map[string]bool{
+	"add":       false,
+	"startall":  false,
+	"stopall":   false,
+	"pauseall":  false,
+	"resumeall": false,
+	"start":     true,
+	"stop":      true,
+	"pause":     true,
+	"resume":    true,
+	"restart":   true,
+	"remove":    true,
+}
+
+// actionRecorder appends the actions taken on the cluster, whether through
+// the UI, the API or a schedule, to the -record file.
+type actionRecorder struct {
+	mu    sync.Mutex
+	f     *os.File
+	start time.Time
+}
+
+// open starts recording to path, replacing any existing recording.
+func (r *actionRecorder) open(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.f, r.start = f, time.Now()
+	return nil
+}
+
+// record appends the action to the recording, if recording.
+func (r *actionRecorder) record(action, node string, count int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return
+	}
+	a := recordedAction{
+		At:     time.Since(r.start).Round(time.Millisecond).String(),
+		Action: action,
+		Node:   node,
+		Count:  count,
+	}
+	b, err := json.Marshal(a)
+	if err != nil {
+		log.Printf("record: %s", err)
+		return
+	}
+	if _, err := r.f.Write(append(b, '\n')); err != nil {
+		log.Printf("record: %s", err)
+	}
+}
+
+// readRecording reads the actions recorded in path, ordered by time. Blank
+// lines and lines starting with # are ignored, so that recordings can be
+// edited or written by hand.
+func readRecording(path string) ([]recordedAction, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var actions []recordedAction
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		var a recordedAction
+		if err := json.Unmarshal([]byte(text), &a); err != nil {
+			return nil, fmt.Errorf("line %d: %s", line, err)
+		}
+		if a.at, err = time.ParseDuration(a.At); err != nil || a.at < 0 {
+			return nil, fmt.Errorf("line %d: invalid time %q", line, a.At)
+		}
+		forNode, ok := replayActions[a.Action]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("line %d: unknown action %q", line, a.Action)
+		case forNode && a.Node == "":
+			return nil, fmt.Errorf("line %d: %s requires a node", line, a.Action)
+		case a.Action == "add" && (a.Count < 0 || a.Count > maxAddCount):
+			return nil, fmt.Errorf("line %d: invalid count %d: must be between 0 and %d; 0 or none adds one node",
+				line, a.Count, maxAddCount)
+		}
+		actions = append(actions, a)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(actions, func(i, j int) bool { return actions[i].at < actions[j].at })
+	return actions, nil
+}
+
+// replayState is the progress of replaying a recording, for the dashboard's
+// banner.
+type replayState struct {
+	File  string
+	Speed float64
+	Total int
+	Done  int
+	// Next is when the next action is executed while replaying.
+	Next     time.Time
+	Started  time.Time
+	Finished time.Time
+	Canceled bool
+}
+
+// clusterReplay is the replay of a recording, if one has been started.
+type clusterReplay struct {
+	mu     sync.Mutex
+	state  *replayState
+	cancel chan struct{}
+}
+
+// Replay returns the progress of the current or last replay, or nil if
+// there has been none.
+func (c *cluster) Replay() *replayState {
+	c.replay.mu.Lock()
+	defer c.replay.mu.Unlock()
+	if c.replay.state == nil {
+		return nil
+	}
+	s := *c.replay.state
+	return &s
+}
+
+// replayAction executes a recorded action, returning an error if it can't
+// be. The action isn't recorded again, so that replaying with -record set
+// doesn't duplicate it.
+func (c *cluster) replayAction(a recordedAction) error {
+	switch a.Action {
+	case "add":
+		count := a.Count
+		if count == 0 {
+			count = 1
+		}
+		if err := c.portsAvailable(count); err != nil {
+			return err
+		}
+		return c.bootNodes(count, *bootConcurrency, *bootStagger)
+	case "startall", "stopall", "pauseall", "resumeall":
+		c.applyAllNodes(a.Action)
+		return nil
+	}
+
+	t, ok := c.Nodes[a.Node]
+	if !ok {
+		return fmt.Errorf("node %s not found", a.Node)
+	}
+	return c.applyNodeAction(t, a.Action)
+}
+
+// runReplay executes the actions at their recorded times divided by speed,
+// until they are all done or cancel is closed. Actions which fail are
+// logged as events and skipped.
+func (c *cluster) runReplay(actions []recordedAction, speed float64, cancel <-chan struct{}) {
+	start := time.Now()
+	for i, a := range actions {
+		at := start.Add(time.Duration(float64(a.at) / speed))
+		c.replay.mu.Lock()
+		c.replay.state.Next = at
+		c.replay.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(at))
+		select {
+		case <-timer.C:
+		case <-cancel:
+			timer.Stop()
+			c.replay.mu.Lock()
+			c.replay.state.Canceled, c.replay.state.Finished = true, time.Now()
+			file := c.replay.state.File
+			c.replay.mu.Unlock()
+			c.events.add("", "replay of %s canceled after %d of %d actions", file, i, len(actions))
+			return
+		}
+
+		if err := c.replayAction(a); err != nil {
+			c.events.add(a.Node, "replay: %s failed: %s", a.Action, err)
+		} else {
+			c.events.add(a.Node, "replay: %s", a.Action)
+		}
+		c.replay.mu.Lock()
+		c.replay.state.Done = i + 1
+		c.replay.mu.Unlock()
+	}
+	c.replay.mu.Lock()
+	c.replay.state.Finished = time.Now()
+	file := c.replay.state.File
+	c.replay.mu.Unlock()
+	c.events.add("", "replay of %s finished", file)
+}
+
+// startReplay replays the recording given by the "file" form value against
+// the cluster, which must have no nodes, at the speed given by the "speed"
+// form value (e.g. 2 to replay twice as fast).
+func (c *cluster) startReplay(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	file := req.FormValue("file")
+	if file == "" {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, "no recording file given")
+		return
+	}
+	speed := 1.0
+	if v := req.FormValue("speed"); v != "" {
+		var err error
+		if speed, err = strconv.ParseFloat(v, 64); err != nil || speed <= 0 {
+			rw.WriteHeader(http.StatusBadRequest)
+			renderError(rw, fmt.Sprintf("invalid speed %q: must be a positive number", v))
+			return
+		}
+	}
+	if len(c.Nodes) > 0 {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, "replaying requires a fresh cluster without nodes; restart roachdemo without -n and with an empty data directory")
+		return
+	}
+	actions, err := readRecording(file)
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("unable to read recording %s: %s", file, err))
+		return
+	}
+
+	c.replay.mu.Lock()
+	if s := c.replay.state; s != nil && s.Finished.IsZero() {
+		c.replay.mu.Unlock()
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, fmt.Sprintf("already replaying %s", s.File))
+		return
+	}
+	cancel := make(chan struct{})
+	c.replay.state = &replayState{File: file, Speed: speed, Total: len(actions), Started: time.Now()}
+	c.replay.cancel = cancel
+	c.replay.mu.Unlock()
+
+	c.events.add("", "replaying %d actions from %s at %gx speed", len(actions), file, speed)
+	go c.runReplay(actions, speed, cancel)
+
+	redirect(rw, req)
+}
+
+// cancelReplay stops the current replay, leaving the nodes as they are.
+func (c *cluster) cancelReplay(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	c.replay.mu.Lock()
+	if s := c.replay.state; s != nil && s.Finished.IsZero() && c.replay.cancel != nil {
+		close(c.replay.cancel)
+		c.replay.cancel = nil
+	}
+	c.replay.mu.Unlock()
+
+	redirect(rw, req)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReplayNotRecorded(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := newCluster(nil, nil, nil, nil, nil, "localhost", "")
+	c.Nodes["1"] = newNode("1", []string{"/bin/true"}, nil, false, "", "", "", "")
+	path := filepath.Join(dir, "recording.jsonl")
+	if err := c.recorder.open(path); err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range []recordedAction{
+		{Action: "stopall"},
+		{Action: "stop", Node: "1"},
+	} {
+		if err := c.replayAction(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 0 {
+		t.Fatalf("expected replayed actions not to be recorded, got %s", b)
+	}
+
+	// Actions taken otherwise are still recorded.
+	if err := c.nodeAction(c.Nodes["1"], "stop"); err != nil {
+		t.Fatal(err)
+	}
+	if b, err = ioutil.ReadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"action":"stop"`) {
+		t.Fatalf("expected the stop to be recorded, got %s", b)
+	}
+}
+
+func TestReadRecordingCount(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testCases := []struct {
+		line string
+		err  string
+	}{
+		{`{"at":"0s","action":"add"}`, ""},
+		{`{"at":"0s","action":"add","count":3}`, ""},
+		{`{"at":"0s","action":"add","count":-1}`, "must be between 0 and"},
+		{`{"at":"0s","action":"add","count":1000}`, "must be between 0 and"},
+	}
+	for _, tc := range testCases {
+		path := filepath.Join(dir, "recording.jsonl")
+		if err := ioutil.WriteFile(path, []byte(tc.line+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := readRecording(path)
+		if tc.err == "" && err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.line, err)
+		} else if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%s: expected an error containing %q, got %v", tc.line, tc.err, err)
+		}
+	}
+}
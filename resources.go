@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// sampleInterval is how often node resource usage is sampled.
+	sampleInterval = 2 * time.Second
+	// maxSamples bounds the resource usage history kept per node.
+	maxSamples = 60
+	// clockTicks is the kernel's USER_HZ, the unit of the /proc cpu times.
+	clockTicks = 100
+)
+
+type resourceSample struct {
+	CPU float64 // percent of a single core
+	RSS int64   // bytes
+}
+
+// resourceHistory is a bounded history of a node's resource usage.
+type resourceHistory struct {
+	mu        sync.Mutex
+	samples   []resourceSample
+	pid       int
+	lastTicks int64
+	lastTime  time.Time
+}
+
+// procStat returns the cumulative cpu time in clock ticks and the resident
+// set size in bytes of the process with the specified pid.
+func procStat(pid int) (ticks int64, rss int64, err error) {
+	b, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, 0, err
+	}
+	// Skip past the parenthesized command name, which may contain spaces.
+	s := string(b)
+	i := strings.LastIndexByte(s, ')')
+	if i < 0 {
+		return 0, 0, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	// fields[0] is the state, the third field of the file.
+	fields := strings.Fields(s[i+1:])
+	if len(fields) < 22 {
+		return 0, 0, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	utime, _ := strconv.ParseInt(fields[11], 10, 64)
+	stime, _ := strconv.ParseInt(fields[12], 10, 64)
+	pages, _ := strconv.ParseInt(fields[21], 10, 64)
+	return utime + stime, pages * int64(os.Getpagesize()), nil
+}
+
+// sample records the current resource usage of the process with the
+// specified pid. A pid of 0 indicates the node is not running.
+func (h *resourceHistory) sample(pid int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if pid == 0 {
+		h.pid = 0
+		return
+	}
+	ticks, rss, err := procStat(pid)
+	if err != nil {
+		h.pid = 0
+		return
+	}
+	now := time.Now()
+	if pid == h.pid {
+		cpu := float64(ticks-h.lastTicks) / clockTicks / now.Sub(h.lastTime).Seconds() * 100
+		h.samples = append(h.samples, resourceSample{CPU: cpu, RSS: rss})
+		if len(h.samples) > maxSamples {
+			h.samples = h.samples[len(h.samples)-maxSamples:]
+		}
+	}
+	h.pid, h.lastTicks, h.lastTime = pid, ticks, now
+}
+
+func (h *resourceHistory) values(f func(resourceSample) float64) []float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	res := make([]float64, len(h.samples))
+	for i, s := range h.samples {
+		res[i] = f(s)
+	}
+	return res
+}
+
+// sparkline renders the values as a small inline SVG polyline, scaled to
+// the maximum value. It returns "" if there are fewer than two values.
+func sparkline(values []float64, title string) template.HTML {
+	if len(values) < 2 {
+		return ""
+	}
+	const width, height = 60, 16
+	max := 0.0
+	for _, v := range values {
+		if v > max {
+			max = v
+		}
+	}
+	var points bytes.Buffer
+	for i, v := range values {
+		y := float64(height)
+		if max > 0 {
+			y -= v / max * height
+		}
+		fmt.Fprintf(&points, "%.1f,%.1f ", float64(i)*width/float64(maxSamples-1), y)
+	}
+	return template.HTML(fmt.Sprintf(
+		`<svg width="%d" height="%d"><title>%s</title><polyline fill="none" stroke="#337ab7" points="%s"/></svg>`,
+		width, height, template.HTMLEscapeString(title), strings.TrimSpace(points.String())))
+}
+
+// CPUSparkline renders the node's recent cpu usage.
+func (n *node) CPUSparkline() template.HTML {
+	values := n.Resources.values(func(s resourceSample) float64 { return s.CPU })
+	if len(values) == 0 {
+		return ""
+	}
+	return sparkline(values, fmt.Sprintf("cpu %.0f%%", values[len(values)-1]))
+}
+
+// MemSparkline renders the node's recent resident memory usage.
+func (n *node) MemSparkline() template.HTML {
+	values := n.Resources.values(func(s resourceSample) float64 { return float64(s.RSS) })
+	if len(values) == 0 {
+		return ""
+	}
+	return sparkline(values, fmt.Sprintf("rss %s", humanizeBytes(int64(values[len(values)-1]))))
+}
+
+// sampleResources periodically samples the resource usage of all nodes, and
+// of the host if -host-stats is set.
+func (c *cluster) sampleResources() {
+	for range time.Tick(sampleInterval) {
+		if *hostStatsFlag {
+			c.hostUsage.sample()
+		}
+		for _, t := range c.Nodes {
+			var pid int
+			if r := t.Active(); r != nil && r.Cmd != nil && r.Cmd.Process != nil {
+				pid = r.Cmd.Process.Pid
+			}
+			t.Resources.sample(pid)
+		}
+	}
+}
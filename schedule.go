@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// clusterSchedule is a stop-all and/or start-all of the nodes scheduled for
+// later, e.g. to pause the cluster during a break in a demo.
+type clusterSchedule struct {
+	mu     sync.Mutex
+	stop   time.Time
+	start  time.Time
+	timers []*time.Timer
+}
+
+// scheduledAction describes the next scheduled action for the dashboard's
+// banner.
+type scheduledAction struct {
+	Action string
+	At     time.Time
+	// Then is the time of the start following a scheduled stop, if any.
+	Then time.Time
+}
+
+// In returns how long until the action, rounded to the second.
+func (a *scheduledAction) In() time.Duration {
+	return time.Until(a.At).Round(time.Second)
+}
+
+// parseScheduleTime parses the time of a scheduled action, given as a
+// duration from now (e.g. 30m), a time of day (e.g. 14:30, tomorrow if it
+// has already passed today) or an RFC 3339 time. An empty value is the zero
+// time.
+func parseScheduleTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		if d <= 0 {
+			return time.Time{}, fmt.Errorf("%q is not in the future", s)
+		}
+		return now.Add(d), nil
+	}
+	if t, err := time.ParseInLocation("15:04", s, now.Location()); err == nil {
+		at := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+		if !at.After(now) {
+			at = at.AddDate(0, 0, 1)
+		}
+		return at, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected a duration, HH:MM or RFC 3339", s)
+	}
+	if !t.After(now) {
+		return time.Time{}, fmt.Errorf("%q is not in the future", s)
+	}
+	return t, nil
+}
+
+// Schedule returns the next scheduled action, or nil if none is scheduled.
+func (c *cluster) Schedule() *scheduledAction {
+	s := &c.schedule
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case !s.stop.IsZero() && (s.start.IsZero() || s.stop.Before(s.start)):
+		return &scheduledAction{Action: "stop", At: s.stop, Then: s.start}
+	case !s.start.IsZero():
+		return &scheduledAction{Action: "start", At: s.start}
+	}
+	return nil
+}
+
+// setSchedule replaces the schedule with a stop-all at stop and a start-all
+// at start, either of which may be zero.
+func (c *cluster) setSchedule(stop, start time.Time) {
+	s := &c.schedule
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range s.timers {
+		t.Stop()
+	}
+	s.timers = nil
+	s.stop, s.start = stop, start
+
+	if !stop.IsZero() {
+		s.timers = append(s.timers, time.AfterFunc(time.Until(stop), func() {
+			// The schedule may have been replaced after the timer fired.
+			s.mu.Lock()
+			current := s.stop.Equal(stop)
+			if current {
+				s.stop = time.Time{}
+			}
+			s.mu.Unlock()
+			if !current {
+				return
+			}
+			c.events.add("", "scheduled stop of all nodes")
+			c.stopAllNodes()
+		}))
+	}
+	if !start.IsZero() {
+		s.timers = append(s.timers, time.AfterFunc(time.Until(start), func() {
+			// The schedule may have been replaced after the timer fired.
+			s.mu.Lock()
+			current := s.start.Equal(start)
+			if current {
+				s.start = time.Time{}
+			}
+			s.mu.Unlock()
+			if !current {
+				return
+			}
+			c.events.add("", "scheduled start of all nodes")
+			c.startAllNodes()
+		}))
+	}
+}
+
+// scheduleAll schedules a stop-all and/or start-all at the times given by
+// the "stop" and "start" form values (see parseScheduleTime), replacing any
+// existing schedule.
+func (c *cluster) scheduleAll(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	now := time.Now()
+	stop, err := parseScheduleTime(req.FormValue("stop"), now)
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("stop: %s", err))
+		return
+	}
+	start, err := parseScheduleTime(req.FormValue("start"), now)
+	if err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("start: %s", err))
+		return
+	}
+	if stop.IsZero() && start.IsZero() {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, "no stop or start time given")
+		return
+	}
+	if !stop.IsZero() && !start.IsZero() && !start.After(stop) {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, "the start must be scheduled after the stop")
+		return
+	}
+
+	c.setSchedule(stop, start)
+	const layout = "Jan 2 15:04:05"
+	switch {
+	case stop.IsZero():
+		c.events.add("", "scheduled start of all nodes at %s", start.Format(layout))
+	case start.IsZero():
+		c.events.add("", "scheduled stop of all nodes at %s", stop.Format(layout))
+	default:
+		c.events.add("", "scheduled stop of all nodes at %s and start at %s",
+			stop.Format(layout), start.Format(layout))
+	}
+
+	redirect(rw, req)
+}
+
+func (c *cluster) cancelSchedule(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	if c.Schedule() != nil {
+		c.setSchedule(time.Time{}, time.Time{})
+		c.events.add("", "canceled scheduled stop/start")
+	}
+
+	redirect(rw, req)
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// StoreDir returns the host directory holding the node's store, or "" if the
+// store is in memory.
+func (n *node) StoreDir() string {
+	if isMemStore(n.Store) {
+		return ""
+	}
+	if n.Container != "" {
+		return n.Dir
+	}
+	if !strings.Contains(n.Store, "=") {
+		return n.Store
+	}
+	for _, field := range strings.Split(n.Store, ",") {
+		if strings.HasPrefix(field, "path=") {
+			return strings.TrimPrefix(field, "path=")
+		}
+	}
+	return ""
+}
+
+// isStore returns whether dir looks like a cockroach store: both RocksDB and
+// Pebble keep a CURRENT file naming the active MANIFEST.
+func isStore(dir string) bool {
+	if _, err := os.Stat(filepath.Join(dir, "CURRENT")); err != nil {
+		return false
+	}
+	manifests, _ := filepath.Glob(filepath.Join(dir, "MANIFEST-*"))
+	return len(manifests) > 0
+}
+
+// seedStore fills the node's empty store directory with the store found at
+// from, which is either a directory or a tarball, optionally gzipped. The
+// store may be at the top level of the tarball or inside a single top-level
+// directory. The node's own logs directory doesn't count against the store
+// being empty. If seeding fails, whatever was put into the store is removed
+// again so that it can be retried.
+func (n *node) seedStore(from string) (err error) {
+	dir := n.StoreDir()
+	if dir == "" {
+		return fmt.Errorf("node %s has an in-memory store", n.Name)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.Name() != "logs" {
+			return fmt.Errorf("store %s of node %s is not empty", dir, n.Name)
+		}
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		entries, _ := ioutil.ReadDir(dir)
+		for _, e := range entries {
+			if e.Name() != "logs" {
+				os.RemoveAll(filepath.Join(dir, e.Name()))
+			}
+		}
+	}()
+
+	fi, err := os.Stat(from)
+	if err != nil {
+		return err
+	}
+	root := from
+	if !fi.IsDir() {
+		// Extract next to the store so the files can be renamed into place.
+		tmp, err := ioutil.TempDir(filepath.Dir(filepath.Clean(dir)), ".seed-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		if err := extractTar(from, tmp); err != nil {
+			return fmt.Errorf("unable to extract %s: %s", from, err)
+		}
+		root = tmp
+		if entries, err := ioutil.ReadDir(tmp); err == nil && len(entries) == 1 && entries[0].IsDir() {
+			root = filepath.Join(tmp, entries[0].Name())
+		}
+	}
+	if !isStore(root) {
+		return fmt.Errorf("%s is not a cockroach store: no CURRENT and MANIFEST files", from)
+	}
+
+	entries, err = ioutil.ReadDir(root)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		// The node keeps its own logs rather than those of the captured
+		// store.
+		if e.Name() == "logs" {
+			continue
+		}
+		src, dst := filepath.Join(root, e.Name()), filepath.Join(dir, e.Name())
+		if root != from {
+			err = os.Rename(src, dst)
+		} else {
+			err = copyTree(src, dst)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractTar extracts the tarball at path into dir. Entries which would be
+// written outside of dir are rejected, and entries other than directories
+// and regular files are skipped.
+func extractTar(path, dir string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = bufio.NewReader(f)
+	if magic, err := r.(*bufio.Reader).Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid path %q", hdr.Name)
+		}
+		target := filepath.Join(dir, name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := writeFile(target, tr, os.FileMode(hdr.Mode).Perm()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// copyTree recursively copies the file or directory src to dst.
+func copyTree(src, dst string) error {
+	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if fi.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return writeFile(target, f, fi.Mode().Perm())
+	})
+}
+
+func writeFile(path string, r io.Reader, perm os.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm|0200)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// seedNode seeds the store of a stopped node from the path given by the
+// "from" form value. See seedStore.
+func (c *cluster) seedNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+	if t.Status() != "Stopped" {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, fmt.Sprintf("node %s must be stopped to seed its store", t.Name))
+		return
+	}
+	from := req.FormValue("from")
+	if from == "" {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, "no store to seed from given")
+		return
+	}
+	if err := t.seedStore(from); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("unable to seed node %s: %s", t.Name, err))
+		return
+	}
+	c.events.add(t.Name, "seeded store from %s", from)
+
+	redirect(rw, req)
+}
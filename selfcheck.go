@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// minOpenFiles is the file descriptor limit recommended by cockroach.
+const minOpenFiles = 15000
+
+type selfCheck struct {
+	Name   string
+	OK     bool
+	Detail string
+}
+
+func checkBinary() selfCheck {
+	check := selfCheck{Name: "cockroach binary"}
+	path, err := exec.LookPath(cockroachBin)
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	version, err := cockroachVersion(path)
+	if err != nil {
+		check.Detail = fmt.Sprintf("%s: %s", path, err)
+		return check
+	}
+	check.OK = true
+	check.Detail = fmt.Sprintf("%s (%s)", path, version)
+	return check
+}
+
+func checkDataDir() selfCheck {
+	check := selfCheck{Name: "data directory"}
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	f, err := ioutil.TempFile(dataDir, ".selfcheck")
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	f.Close()
+	os.Remove(f.Name())
+	check.OK = true
+	check.Detail = fmt.Sprintf("%s is writable", dataDir)
+	return check
+}
+
+func portFree(host string, port int) error {
+	l, err := net.Listen("tcp", net.JoinHostPort(host, fmt.Sprint(port)))
+	if err != nil {
+		return err
+	}
+	return l.Close()
+}
+
+// checkPorts verifies that the ports of stopped nodes, and the ports the next
+// added node will use, are not in use by another process.
+func (c *cluster) checkPorts() selfCheck {
+	check := selfCheck{Name: "ports"}
+	// hosts maps each port to the host it will be bound on.
+	hosts := map[int]string{
+		c.NextPort:     c.host,
+		c.NextPort + 1: c.httpHost,
+	}
+	if c.NextSQLPort != 0 {
+		hosts[c.NextSQLPort] = c.host
+	}
+	for _, t := range c.Nodes {
+		if t.Status() == "Stopped" {
+			hosts[t.Port] = c.host
+			hosts[t.SQLPort] = c.host
+			hosts[t.HTTPPort] = c.httpHost
+		}
+	}
+	var ports []int
+	for port := range hosts {
+		ports = append(ports, port)
+	}
+	sort.Ints(ports)
+
+	var busy []string
+	for _, port := range ports {
+		if err := portFree(hosts[port], port); err != nil {
+			busy = append(busy, fmt.Sprint(port))
+		}
+	}
+	if len(busy) > 0 {
+		check.Detail = fmt.Sprintf("in use: %s", strings.Join(busy, ", "))
+		return check
+	}
+	check.OK = true
+	check.Detail = fmt.Sprintf("%d ports free", len(ports))
+	return check
+}
+
+func checkOpenFiles() selfCheck {
+	check := selfCheck{Name: "open file limit"}
+	var rlimit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlimit); err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	check.OK = rlimit.Cur >= minOpenFiles
+	check.Detail = fmt.Sprintf("%d (recommended at least %d)", rlimit.Cur, minOpenFiles)
+	return check
+}
+
+func (c *cluster) selfCheck(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	checks := []selfCheck{
+		checkBinary(),
+		checkDataDir(),
+		c.checkPorts(),
+		checkOpenFiles(),
+	}
+
+	data := map[string]interface{}{
+		"Title":   "self check",
+		"Page":    "SelfCheck",
+		"Cluster": c,
+		"Checks":  checks,
+	}
+	renderLayout(rw, req, "selfcheck.html", "layout.html", "Content", data)
+}
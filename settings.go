@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// settingsError is a node whose settings could not be queried.
+type settingsError struct {
+	Name  string
+	Error string
+}
+
+// settingRow is a setting's value on each queried node, in the order of the
+// queried nodes.
+type settingRow struct {
+	Name   string
+	Values []string
+	Differ bool
+}
+
+// nodeSettings returns the node's settings, keyed by name, from the
+// variable and value columns of the query's result.
+func (n *node) nodeSettings(query string) (map[string]string, error) {
+	rows, err := n.sqlRows(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no output")
+	}
+	nameCol, valueCol := -1, -1
+	for i, col := range rows[0] {
+		switch col {
+		case "variable":
+			nameCol = i
+		case "value":
+			valueCol = i
+		}
+	}
+	if nameCol < 0 || valueCol < 0 {
+		return nil, fmt.Errorf("unexpected columns %q", rows[0])
+	}
+	settings := map[string]string{}
+	for _, row := range rows[1:] {
+		if nameCol < len(row) && valueCol < len(row) {
+			settings[row[nameCol]] = row[valueCol]
+		}
+	}
+	return settings, nil
+}
+
+// settingsDiff compares the settings of the running nodes, highlighting the
+// settings whose values differ. Cluster settings are compared by default;
+// kind=session compares the session variables instead.
+func (c *cluster) settingsDiff(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	kind := req.FormValue("kind")
+	query := "SHOW ALL CLUSTER SETTINGS"
+	if kind == "session" {
+		query = "SHOW ALL"
+	} else {
+		kind = "cluster"
+	}
+
+	var nodes []string
+	var errors []settingsError
+	var settings []map[string]string
+	names := map[string]bool{}
+	for _, t := range c.sortedNodes() {
+		if t.Status() != "Running" {
+			continue
+		}
+		s, err := t.nodeSettings(query)
+		if err != nil {
+			errors = append(errors, settingsError{Name: t.Name, Error: err.Error()})
+			continue
+		}
+		nodes = append(nodes, t.Name)
+		settings = append(settings, s)
+		for name := range s {
+			names[name] = true
+		}
+	}
+
+	var rows []settingRow
+	var differ int
+	for name := range names {
+		row := settingRow{Name: name}
+		for i, s := range settings {
+			value, ok := s[name]
+			if !ok {
+				value = "(missing)"
+			}
+			row.Values = append(row.Values, value)
+			if i > 0 && value != row.Values[0] {
+				row.Differ = true
+			}
+		}
+		if row.Differ {
+			differ++
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Differ != rows[j].Differ {
+			return rows[i].Differ
+		}
+		return rows[i].Name < rows[j].Name
+	})
+
+	data := map[string]interface{}{
+		"Title":    "settings",
+		"Page":     "Settings",
+		"Cluster":  c,
+		"Kind":     kind,
+		"Nodes":    nodes,
+		"Errors":   errors,
+		"Settings": rows,
+		"Differ":   differ,
+	}
+	renderLayout(rw, req, "settings.html", "layout.html", "Content", data)
+}
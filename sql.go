@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// sqlPassword is the root password read from -sql-password-file, if any.
+// It must never be logged.
+var sqlPassword string
+
+// withPassword returns the SQL connection string u authenticating as root
+// with the password. Only secure clusters check passwords, and they require
+// TLS.
+func withPassword(u, password string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return u
+	}
+	parsed.User = url.UserPassword("root", password)
+	q := parsed.Query()
+	q.Set("sslmode", "require")
+	parsed.RawQuery = q.Encode()
+	return parsed.String()
+}
+
+// sql runs the query against the node using "cockroach sql" with the
+// additional flags and returns its combined output. With -sql-password-file
+// the node is assumed to be secure and is connected to with the password,
+// otherwise with --insecure.
+func (n *node) sql(query string, flags ...string) ([]byte, error) {
+	bin := cockroachBin
+	if n.Container == "" {
+		bin = n.Binary()
+	}
+	args := []string{"sql", "-e", query}
+	sqlURL := n.SQLURL()
+	if sqlPassword == "" {
+		args = append(args, "--insecure")
+	} else {
+		sqlURL = withPassword(sqlURL, sqlPassword)
+	}
+	args = append(args, flags...)
+	// The URL is passed through the environment rather than the command line
+	// so that the password, if any, doesn't show up in process listings.
+	env := append(os.Environ(), "COCKROACH_URL="+sqlURL)
+	out, err := runCommand(n.runTimeout(*commandTimeout), env, bin, args...)
+	if err != nil {
+		if msg := bytes.TrimSpace(out); len(msg) > 0 {
+			return out, fmt.Errorf("%s: %s", err, msg)
+		}
+		return out, err
+	}
+	return out, nil
+}
+
+// sqlRows runs the query against the node and returns the result rows,
+// including the header row.
+func (n *node) sqlRows(query string) ([][]string, error) {
+	out, err := n.sql(query, "--format=csv")
+	if err != nil {
+		return nil, err
+	}
+	r := csv.NewReader(bytes.NewReader(out))
+	r.FieldsPerRecord = -1
+	return r.ReadAll()
+}
+
+// rangesLog returns the path of the log the node's range dumps are appended
+// to.
+func (n *node) rangesLog() string {
+	return filepath.Join(n.Dir, "logs", "ranges.log")
+}
+
+// nodeRanges dumps the ranges as seen by the node to the node's ranges log.
+func (c *cluster) nodeRanges(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	if t.Status() != "Running" {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		renderError(rw, fmt.Sprintf("node %s is %s", t.Name, t.Status()))
+		return
+	}
+
+	out, err := t.sql("SELECT * FROM crdb_internal.ranges_no_leases")
+	if err != nil {
+		rw.WriteHeader(http.StatusBadGateway)
+		renderError(rw, fmt.Sprintf("unable to query ranges from node %s: %s", t.Name, err))
+		return
+	}
+
+	f, err := os.OpenFile(t.rangesLog(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err == nil {
+		_, err = fmt.Fprintf(f, "=== %s\n%s\n", time.Now().Format(time.RFC3339), out)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
+
+	rw.Header().Set("Location", fmt.Sprintf("%s/node/%s/ranges/log", *basePath, t.Name))
+	rw.WriteHeader(http.StatusFound)
+}
+
+func (c *cluster) nodeRangesLog(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	b, err := ioutil.ReadFile(t.rangesLog())
+	if err != nil && !os.IsNotExist(err) {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":     "Node ranges",
+		"Page":      "NodeOutput",
+		"Type":      "ranges",
+		"Cluster":   c,
+		"Node":      t,
+		"LogOutput": logOutput(req, string(b)),
+		"Color":     req.FormValue("color") == "true",
+	}
+
+	renderLayout(rw, req, "log.html", "layout.html", "Content", data)
+}
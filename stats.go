@@ -0,0 +1,32 @@
+package main
+
+import (
+	"expvar"
+	"net/http"
+	"runtime"
+)
+
+// Internal roachdemo counters, exported via /debug/vars.
+var (
+	statNodesCreated  = expvar.NewInt("nodes_created")
+	statStarts        = expvar.NewInt("starts")
+	statCrashes       = expvar.NewInt("crashes")
+	statRestarts      = expvar.NewInt("restarts")
+	statSubscriptions = expvar.NewInt("log_subscriptions")
+
+	statReconcileStarts = expvar.NewInt("reconcile_starts")
+	statSinkDropped     = expvar.NewInt("log_sink_dropped")
+
+	// statName is the -name of this roachdemo.
+	statName = expvar.NewString("name")
+)
+
+func init() {
+	expvar.Publish("goroutines", expvar.Func(func() interface{} {
+		return runtime.NumGoroutine()
+	}))
+}
+
+func debugVars(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	expvar.Handler().ServeHTTP(rw, req)
+}
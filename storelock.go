@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"regexp"
+)
+
+// storeLockedRE matches the error cockroach exits with when its store is
+// already open by another process: both RocksDB and Pebble fail to lock the
+// store's LOCK file with EAGAIN.
+var storeLockedRE = regexp.MustCompile(`(?i)lock.*resource temporarily unavailable|store directory .*locked`)
+
+// storeLockPathRE matches the path of the LOCK file named by the error.
+var storeLockPathRE = regexp.MustCompile(`([^\s"':]+)/LOCK\b`)
+
+// storeLockScan is how much of the end of a run's output is searched for
+// the store locked error.
+const storeLockScan = 64 << 10
+
+// lockedStore returns the store directory of the node found to be locked by
+// another process in the output of the run, which has exited, and whether
+// the run failed that way. The directory is taken from the error if it
+// names the LOCK file, and is otherwise the node's store directory.
+func (n *node) lockedStore(r *nodeRun) (string, bool) {
+	path := r.Stderr
+	if r.Merged {
+		path = r.Stdout
+	}
+	if path == "" {
+		return "", false
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", false
+	}
+	offset := fi.Size() - storeLockScan
+	if offset < 0 {
+		offset = 0
+	}
+	out, err := fileLogWriter{filename: path}.readFrom(offset, storeLockScan)
+	if err != nil {
+		return "", false
+	}
+	if !storeLockedRE.Match(out) {
+		return "", false
+	}
+	// The paths in the errors of docker nodes are inside the container.
+	if m := storeLockPathRE.FindSubmatch(out); m != nil && n.Container == "" {
+		return string(m[1]), true
+	}
+	return n.StoreDir(), true
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLockedStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-storelock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testCases := []struct {
+		name   string
+		output string
+		store  string
+		locked bool
+	}{
+		{"pebble", "ERROR: could not open store: lock /data/other/LOCK: resource temporarily unavailable\n", "/data/other", true},
+		{"store directory", "E: store directory cockroach-data/1 is locked by another process\n", "cockroach-data/1", true},
+		{"other failure", "ERROR: no space left on device\n", "", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, tc.name+".stderr")
+			if err := ioutil.WriteFile(path, []byte("starting\n"+tc.output), 0644); err != nil {
+				t.Fatal(err)
+			}
+			n := newNode("1", nil, nil, false, "", "", "", "")
+			n.Store = "cockroach-data/1"
+			store, locked := n.lockedStore(&nodeRun{Stderr: path})
+			if store != tc.store || locked != tc.locked {
+				t.Fatalf("expected %q, %t, got %q, %t", tc.store, tc.locked, store, locked)
+			}
+		})
+	}
+}
+
+func TestStoreLockedNotRestarted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-storelock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	script := "echo 'lock " + dir + "/LOCK: resource temporarily unavailable' >&2; exit 1"
+	n := newNode("1", []string{"/bin/sh", "-c", script}, nil, false,
+		filepath.Join(dir, "${RUN}.stdout"), filepath.Join(dir, "${RUN}.stderr"), "", "")
+	n.startService()
+	waitRun(t, n.Runs[0])
+
+	// The exit is handled after the post-stop hook has run. A node which is
+	// no longer a service isn't restarted.
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		n.startMu.Lock()
+		locked, service := n.StoreLocked, n.Service
+		n.startMu.Unlock()
+		if locked != "" {
+			if locked != dir || service {
+				t.Fatalf("expected store %s locked and the node no longer a service, got %q and %t",
+					dir, locked, service)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the locked store to be detected")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !n.Runs[0].StoreLocked {
+		t.Errorf("expected the run to be marked as failing on the locked store")
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// tenantBasePort is the first port of the range the SQL and HTTP ports of
+// tenant SQL servers are allocated from.
+const tenantBasePort = 36257
+
+// newTenant creates a "cockroach mt start-sql" process serving the specified
+// tenant from the cluster's KV nodes. The process is managed like a node and
+// is started immediately.
+func (c *cluster) newTenant(id int) (*node, error) {
+	name := strconv.Itoa(id)
+	dir := filepath.Join(dataDir, "tenant-"+name)
+	logdir := filepath.Join(dir, "logs")
+	if err := os.MkdirAll(logdir, 0755); err != nil {
+		return nil, fmt.Errorf("unable to create tenant %s: %s", name, err)
+	}
+
+	port := c.NextTenantPort
+	httpPort := c.NextTenantPort + 1
+	c.NextTenantPort += 2
+
+	var kvAddrs string
+	for _, t := range c.sortedNodes() {
+		if kvAddrs != "" {
+			kvAddrs += ","
+		}
+		kvAddrs += net.JoinHostPort(t.Host, strconv.Itoa(t.Port))
+	}
+
+	args := []string{
+		cockroachBin,
+		"mt",
+		"start-sql",
+		"--insecure",
+		fmt.Sprintf("--tenant-id=%d", id),
+		fmt.Sprintf("--kv-addrs=%s", kvAddrs),
+		fmt.Sprintf("--sql-addr=%s", net.JoinHostPort(c.host, strconv.Itoa(port))),
+		fmt.Sprintf("--http-addr=%s", net.JoinHostPort(c.httpHost, strconv.Itoa(httpPort))),
+		fmt.Sprintf("--store=%s", dir),
+	}
+
+	t := newNode(name, args, nil, false, filepath.Join(logdir, "${RUN}.stdout"),
+		filepath.Join(logdir, "${RUN}.stderr"), "", "")
+	t.URL = fmt.Sprintf("http://%s", net.JoinHostPort(c.httpAdvertiseHost, strconv.Itoa(httpPort)))
+	t.Host = c.advertiseHost
+	t.Port = port
+	t.SQLPort = port
+	t.HTTPPort = httpPort
+	t.Dir = dir
+	t.Store = dir
+	c.Tenants[name] = t
+	c.events.add("tenant "+name, "created")
+
+	t.Service = true
+	t.start()
+	return t, nil
+}
+
+// addTenant creates the tenant specified by the "tenant-id" form value in
+// the KV cluster and starts a SQL server for it.
+func (c *cluster) addTenant(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	id, err := strconv.Atoi(req.FormValue("tenant-id"))
+	if err != nil || id < 2 {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("invalid tenant ID %q: must be an integer of at least 2", req.FormValue("tenant-id")))
+		return
+	}
+	if _, ok := c.Tenants[strconv.Itoa(id)]; ok {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("tenant %d already exists", id))
+		return
+	}
+
+	t, ok := c.Nodes["1"]
+	if !ok || t.Status() != "Running" {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		renderError(rw, "node 1 must be running to create a tenant")
+		return
+	}
+	if _, err := t.sql(fmt.Sprintf("SELECT crdb_internal.create_tenant(%d)", id)); err != nil {
+		rw.WriteHeader(http.StatusBadGateway)
+		renderError(rw, fmt.Sprintf("unable to create tenant %d: %s", id, err))
+		return
+	}
+
+	if _, err := c.newTenant(id); err != nil {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
+
+	redirect(rw, req)
+}
+
+func (c *cluster) findTenant(rw http.ResponseWriter, args map[string]string) *node {
+	id := args["tenant"]
+	t, ok := c.Tenants[id]
+	if !ok {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, fmt.Sprintf("tenant %s not found", id))
+		return nil
+	}
+	return t
+}
+
+func (c *cluster) startTenant(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findTenant(rw, args)
+	if t == nil {
+		return
+	}
+
+	t.startService()
+
+	redirect(rw, req)
+}
+
+func (c *cluster) stopTenant(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findTenant(rw, args)
+	if t == nil {
+		return
+	}
+
+	t.stopService()
+
+	redirect(rw, req)
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+// tracers are the tools a node's run may be traced with.
+var tracers = map[string]bool{
+	"strace": true,
+	"ltrace": true,
+}
+
+// checkTrace returns an error if the node's next run can't be traced with
+// tool.
+func (n *node) checkTrace(tool string) error {
+	if !*allowTracing {
+		return fmt.Errorf("tracing is disabled; restart roachdemo with -allow-tracing")
+	}
+	if !tracers[tool] {
+		return fmt.Errorf("unsupported tracer %q", tool)
+	}
+	if runtime.GOOS != "linux" {
+		return fmt.Errorf("tracing requires Linux, not %s", runtime.GOOS)
+	}
+	if n.Container != "" {
+		return fmt.Errorf("node %s runs in a container and can't be traced", n.Name)
+	}
+	if _, err := exec.LookPath(tool); err != nil {
+		return err
+	}
+	return nil
+}
+
+// tracePath returns the path of the trace of the specified run by tool.
+func (n *node) tracePath(run int, tool string) string {
+	return filepath.Join(n.Dir, "logs", fmt.Sprintf("%d.%s", run, tool))
+}
+
+// traceArgs returns args modified to run the process under tool, following
+// forks and writing the trace to path.
+func traceArgs(args []string, tool, path string) []string {
+	return append([]string{tool, "-f", "-o", path}, args...)
+}
+
+// traceNode restarts the node with its next run traced by the tool given by
+// the "tool" form value, strace or ltrace.
+func (c *cluster) traceNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	tool := req.FormValue("tool")
+	if err := t.checkTrace(tool); err != nil {
+		rw.WriteHeader(http.StatusBadRequest)
+		renderError(rw, err.Error())
+		return
+	}
+
+	t.TraceNext = tool
+	c.events.add(t.Name, "restarting with %s attached", tool)
+	t.gracefulRestart()
+
+	redirect(rw, req)
+}
+
+func (c *cluster) nodeRunTrace(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+
+	run := c.findNodeRun(rw, t, args)
+	if run == nil {
+		return
+	}
+
+	if run.Tracer == "" {
+		rw.WriteHeader(http.StatusNotFound)
+		renderError(rw, fmt.Sprintf("run %d of node %s was not traced", run.ID, t.Name))
+		return
+	}
+	b, err := ioutil.ReadFile(run.tracePath)
+	if err != nil && !os.IsNotExist(err) {
+		rw.WriteHeader(http.StatusInternalServerError)
+		renderError(rw, err.Error())
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":     "Node run trace",
+		"Page":      "NodeOutput",
+		"Type":      run.Tracer,
+		"Cluster":   c,
+		"Node":      t,
+		"NodeRun":   run,
+		"LogOutput": logOutput(req, string(b)),
+		"Color":     req.FormValue("color") == "true",
+	}
+
+	renderLayout(rw, req, "log.html", "layout.html", "Content", data)
+}
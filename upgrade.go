@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// clusterVersionTTL is how long the cluster version queried from the
+// bootstrap node is cached.
+const clusterVersionTTL = 10 * time.Second
+
+// versionCache caches the versions of cockroach binaries, keyed by path, and
+// the cluster version.
+type versionCache struct {
+	mu       sync.Mutex
+	binaries map[string]string
+	cluster  string
+	queried  time.Time
+	// refreshing is set while the cluster version is being queried.
+	refreshing bool
+	// builds are the builds of the binaries run, see binaryBuild. They
+	// have their own mutex so that probing a binary when a node starts
+	// doesn't wait on version queries.
+	buildsMu sync.Mutex
+	builds   map[string]cachedBuild
+}
+
+var versions versionCache
+
+// binaryVersion returns the version of the cockroach binary at path.
+func (v *versionCache) binaryVersion(path string) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if version, ok := v.binaries[path]; ok {
+		return version
+	}
+	version, err := cockroachVersion(path)
+	if err != nil {
+		version = "unknown version"
+	}
+	if v.binaries == nil {
+		v.binaries = map[string]string{}
+	}
+	v.binaries[path] = version
+	return version
+}
+
+// invalidateCluster forces the cluster version to be queried again.
+func (v *versionCache) invalidateCluster() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.queried = time.Time{}
+}
+
+// clusterVersion returns the active cluster version last reported by t. Once
+// it is older than clusterVersionTTL it is queried again in the background,
+// so that rendering a page never waits for SQL.
+func (v *versionCache) clusterVersion(t *node) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if time.Since(v.queried) >= clusterVersionTTL && !v.refreshing {
+		v.refreshing = true
+		go v.refreshCluster(t)
+	}
+	if v.cluster == "" {
+		return "unknown"
+	}
+	return v.cluster
+}
+
+// refreshCluster queries the active cluster version from t.
+func (v *versionCache) refreshCluster(t *node) {
+	version := "unknown"
+	if rows, err := t.sqlRows("SHOW CLUSTER SETTING version"); err == nil && len(rows) > 1 && len(rows[1]) > 0 {
+		version = rows[1][0]
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.cluster = version
+	v.queried = time.Now()
+	v.refreshing = false
+}
+
+// binaryVersion is a cockroach version and the nodes running it.
+type binaryVersion struct {
+	Version string
+	Nodes   []string
+}
+
+type upgradeStatus struct {
+	// ClusterVersion is the active cluster version, empty if the bootstrap
+	// node isn't running.
+	ClusterVersion string
+	Binaries       []binaryVersion
+}
+
+// Mixed returns whether the nodes run more than one cockroach version.
+func (s upgradeStatus) Mixed() bool {
+	return len(s.Binaries) > 1
+}
+
+// Upgrade returns the cluster version and the versions of the nodes'
+// binaries. Docker nodes are omitted as their version is that of the image.
+func (c *cluster) Upgrade() upgradeStatus {
+	var s upgradeStatus
+	byVersion := map[string][]string{}
+	for _, t := range c.sortedNodes() {
+		if t.Container != "" {
+			continue
+		}
+		version := versions.binaryVersion(t.Binary())
+		byVersion[version] = append(byVersion[version], t.Name)
+	}
+	for version, nodes := range byVersion {
+		s.Binaries = append(s.Binaries, binaryVersion{Version: version, Nodes: nodes})
+	}
+	sort.Slice(s.Binaries, func(i, j int) bool {
+		return s.Binaries[i].Version < s.Binaries[j].Version
+	})
+	if t, ok := c.Nodes[bootstrapNode]; ok && t.Status() == "Running" {
+		s.ClusterVersion = versions.clusterVersion(t)
+	}
+	return s
+}
+
+// finalizeUpgrade finalizes an upgrade by setting the cluster version to the
+// version of the bootstrap node's binary. Finalizing while the nodes run
+// mixed versions is refused unless force=true.
+func (c *cluster) finalizeUpgrade(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	if req.Method != http.MethodPost {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+		renderError(rw, "finalizing an upgrade must be requested with POST")
+		return
+	}
+	t, ok := c.Nodes[bootstrapNode]
+	if !ok || t.Status() != "Running" {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		renderError(rw, fmt.Sprintf("node %s must be running to finalize an upgrade", bootstrapNode))
+		return
+	}
+	if s := c.Upgrade(); s.Mixed() && req.FormValue("force") != "true" {
+		var mixed []string
+		for _, b := range s.Binaries {
+			mixed = append(mixed, fmt.Sprintf("%s on nodes %s", b.Version, strings.Join(b.Nodes, ", ")))
+		}
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, fmt.Sprintf("nodes run mixed versions (%s); upgrade all nodes before finalizing",
+			strings.Join(mixed, "; ")))
+		return
+	}
+
+	out, err := t.sql("SET CLUSTER SETTING version = crdb_internal.node_executable_version()")
+	versions.invalidateCluster()
+	if err != nil {
+		rw.WriteHeader(http.StatusBadGateway)
+		renderError(rw, fmt.Sprintf("unable to finalize upgrade: %s", err))
+		return
+	}
+	c.events.add("", "finalized upgrade: %s", strings.TrimSpace(string(out)))
+
+	redirect(rw, req)
+}
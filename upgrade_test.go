@@ -0,0 +1,40 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestClusterVersionDoesNotBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "roachdemo-version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bin := filepath.Join(dir, "cockroach")
+	script := "#!/bin/sh\nsleep 1\nprintf 'version\\n22.1\\n'\n"
+	if err := ioutil.WriteFile(bin, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	n := newNode("1", []string{bin, "start"}, nil, false, "", "", "", "")
+
+	var v versionCache
+	start := time.Now()
+	if got := v.clusterVersion(n); got != "unknown" {
+		t.Fatalf("expected the version to be unknown until queried, got %s", got)
+	}
+	if d := time.Since(start); d > 500*time.Millisecond {
+		t.Fatalf("expected the query not to block, took %s", d)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for v.clusterVersion(n) != "22.1" {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the version to be refreshed in the background")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
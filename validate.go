@@ -0,0 +1,250 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// helpFlagRE matches a flag in the output of "cockroach start --help", e.g.
+// "  -s, --store <spec>   description" or "      --background". The type of
+// a flag which takes a value follows it after a single space.
+var helpFlagRE = regexp.MustCompile(`^\s+(?:-(\w), )?--([\w-]+)( \S+)?(?:\s{2,}|\s*$)`)
+
+// managedFlags are the flags roachdemo sets for each node itself, as given
+// by nodeFlags, and --listen-addr, which overrides --host and --port.
+var managedFlags = append(flagNames(nodeFlags("host", "host", 1, "host:1", "store", "host:1", "host:1")), "--listen-addr")
+
+// flagNames returns the names of the flags of args, which are of the form
+// --name=value.
+func flagNames(args []string) []string {
+	names := make([]string, len(args))
+	for i, arg := range args {
+		names[i] = strings.SplitN(arg, "=", 2)[0]
+	}
+	return names
+}
+
+// startFlags caches the flags accepted by "cockroach start", keyed by binary.
+// Each flag, without its dashes, maps to whether it takes a value.
+var startFlags = struct {
+	sync.Mutex
+	flags map[string]map[string]bool
+}{flags: map[string]map[string]bool{}}
+
+// cockroachStartFlags returns the flags accepted by "cockroach start" as
+// listed by its help.
+func cockroachStartFlags(bin string) (map[string]bool, error) {
+	startFlags.Lock()
+	defer startFlags.Unlock()
+	if flags, ok := startFlags.flags[bin]; ok {
+		return flags, nil
+	}
+
+	out, err := runCommand(0, nil, bin, "start", "--help")
+	if err != nil {
+		return nil, fmt.Errorf("%s start --help: %s", bin, err)
+	}
+	flags := map[string]bool{}
+	for _, line := range strings.Split(string(out), "\n") {
+		m := helpFlagRE.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		takesValue := m[3] != ""
+		flags[m[2]] = takesValue
+		if m[1] != "" {
+			flags[m[1]] = takesValue
+		}
+	}
+	if len(flags) == 0 {
+		return nil, fmt.Errorf("%s start --help lists no flags", bin)
+	}
+	startFlags.flags[bin] = flags
+	return flags, nil
+}
+
+type argProblem struct {
+	Arg     string `json:"arg,omitempty"`
+	Problem string `json:"problem"`
+}
+
+type argValidation struct {
+	// Args are the node's args with the proposed args appended, if a node
+	// was specified, and otherwise the proposed args.
+	Args     []string     `json:"args"`
+	Valid    bool         `json:"valid"`
+	Problems []argProblem `json:"problems"`
+	Warnings []argProblem `json:"warnings"`
+}
+
+// validateArgs checks args proposed to be appended to base, the args of a
+// node, or nil. Unknown flags and missing values are only detected if flags,
+// the flags accepted by the node's binary, is non-nil.
+func validateArgs(base, proposed []string, flags map[string]bool) argValidation {
+	v := argValidation{
+		Args:     append(append([]string(nil), base...), proposed...),
+		Problems: []argProblem{},
+		Warnings: []argProblem{},
+	}
+	problem := func(arg, format string, args ...interface{}) {
+		v.Problems = append(v.Problems, argProblem{Arg: arg, Problem: fmt.Sprintf(format, args...)})
+	}
+
+	for i := 0; i < len(proposed); i++ {
+		arg := proposed[i]
+		if !strings.HasPrefix(arg, "-") || arg == "-" || arg == "--" {
+			problem(arg, "not a flag: cockroach start takes no positional arguments")
+			continue
+		}
+		name, value := strings.TrimLeft(arg, "-"), ""
+		hasValue := false
+		if j := strings.Index(name, "="); j >= 0 {
+			name, value, hasValue = name[:j], name[j+1:], true
+		}
+		if strings.HasPrefix(arg, "--") {
+			for _, managed := range managedFlags {
+				if "--"+name == managed {
+					v.Warnings = append(v.Warnings, argProblem{Arg: arg,
+						Problem: fmt.Sprintf("%s is set by roachdemo for each node; overriding it may break the node", managed)})
+				}
+			}
+		}
+		if flags == nil {
+			continue
+		}
+		takesValue, ok := flags[name]
+		if !ok {
+			if s := closestFlag(name, flags); s != "" {
+				problem(arg, "unknown flag; did you mean --%s?", s)
+			} else {
+				problem(arg, "unknown flag")
+			}
+			continue
+		}
+		switch {
+		case takesValue && !hasValue:
+			if i+1 >= len(proposed) || strings.HasPrefix(proposed[i+1], "-") {
+				problem(arg, "flag needs a value")
+				continue
+			}
+			i++
+		case !takesValue && hasValue && value != "true" && value != "false":
+			problem(arg, "boolean flag given value %q", value)
+		}
+	}
+
+	if base != nil {
+		// The last occurrence of --insecure wins.
+		insecure, certs := false, false
+		for _, arg := range v.Args {
+			switch {
+			case arg == "--insecure" || arg == "--insecure=true":
+				insecure = true
+			case arg == "--insecure=false":
+				insecure = false
+			case strings.HasPrefix(arg, "--certs-dir"):
+				certs = true
+			}
+		}
+		if !insecure && !certs {
+			problem("", "one of --insecure or --certs-dir is required")
+		}
+	}
+
+	v.Valid = len(v.Problems) == 0
+	return v
+}
+
+// closestFlag returns the flag closest to name within an edit distance of 2,
+// or "" if there is none.
+func closestFlag(name string, flags map[string]bool) string {
+	best, bestDist := "", 3
+	for flag := range flags {
+		if len(flag) < 2 {
+			continue
+		}
+		if d := editDistance(name, flag); d < bestDist || (d == bestDist && flag < best) {
+			best, bestDist = flag, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// apiValidateArgs validates the proposed args given by the "args" form value,
+// one per line as in the -args-file, without applying them. If the "node"
+// form value is set they are validated as additions to that node's args and
+// against its binary, and otherwise against the default binary.
+func (c *cluster) apiValidateArgs(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "validating args requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	proposed := parseArgs(req.FormValue("args"))
+	if len(proposed) == 0 {
+		http.Error(rw, "no args given", http.StatusBadRequest)
+		return
+	}
+
+	var base []string
+	bin := cockroachBin
+	var container bool
+	if name := req.FormValue("node"); name != "" {
+		t, ok := c.Nodes[name]
+		if !ok {
+			http.Error(rw, fmt.Sprintf("node %s not found", name), http.StatusNotFound)
+			return
+		}
+		base = t.Args
+		bin = t.Binary()
+		container = t.Container != ""
+	}
+
+	var flags map[string]bool
+	var flagsErr error
+	if container || *dockerImage != "" {
+		flagsErr = fmt.Errorf("the flags of a docker image can't be listed")
+	} else {
+		flags, flagsErr = cockroachStartFlags(bin)
+	}
+	v := validateArgs(base, proposed, flags)
+	if flagsErr != nil {
+		v.Warnings = append(v.Warnings, argProblem{
+			Problem: fmt.Sprintf("flags not checked against cockroach: %s", flagsErr),
+		})
+	}
+	writeJSON(rw, v)
+}
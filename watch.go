@@ -0,0 +1,93 @@
+package main
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+// watchInterval is how often a watched file is checked for changes.
+const watchInterval = time.Second
+
+// rollingRestartDelay is how long a rolling restart waits after restarting
+// a node before moving on to the next one.
+const rollingRestartDelay = 5 * time.Second
+
+// replaceArgs removes one occurrence of each of old from args and appends
+// new.
+func replaceArgs(args, old, new []string) []string {
+	res := append([]string(nil), args...)
+	for _, o := range old {
+		for i, arg := range res {
+			if arg == o {
+				res = append(res[:i], res[i+1:]...)
+				break
+			}
+		}
+	}
+	return append(res, new...)
+}
+
+func equalArgs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// setFileArgs replaces the args read from the -args-file for future nodes
+// and in the args of existing nodes. Changes to existing nodes take effect
+// when they are next started.
+func (c *cluster) setFileArgs(args []string) {
+	for _, t := range c.Nodes {
+		t.Args = replaceArgs(t.Args, c.fileArgs, args)
+	}
+	c.fileArgs = args
+}
+
+// rollingRestart gracefully restarts the running nodes one at a time.
+func (c *cluster) rollingRestart() {
+	for _, t := range c.sortedNodes() {
+		if t.Active() == nil {
+			continue
+		}
+		c.events.add(t.Name, "rolling restart")
+		t.gracefulRestart()
+		time.Sleep(rollingRestartDelay)
+	}
+}
+
+// watchArgsFile polls the args file for modifications and applies the new
+// args to the cluster, optionally followed by a rolling restart.
+func (c *cluster) watchArgsFile(path string, restart bool) {
+	var lastMod time.Time
+	if fi, err := os.Stat(path); err == nil {
+		lastMod = fi.ModTime()
+	}
+	for range time.Tick(watchInterval) {
+		fi, err := os.Stat(path)
+		if err != nil || fi.ModTime().Equal(lastMod) {
+			continue
+		}
+		lastMod = fi.ModTime()
+
+		args, err := readArgsFile(path)
+		if err != nil {
+			log.Print(err)
+			continue
+		}
+		if equalArgs(args, c.fileArgs) {
+			continue
+		}
+		c.events.add("", "%s changed: args %q replaced by %q", path, c.fileArgs, args)
+		c.setFileArgs(args)
+		if restart {
+			c.rollingRestart()
+		}
+	}
+}
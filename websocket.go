@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A minimal server side implementation of the WebSocket protocol (RFC 6455)
+// sufficient for streaming text messages to a browser and receiving small
+// control messages back. Fragmented messages are not supported.
+
+const wsGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// wsMaxPayload bounds the size of messages accepted from clients.
+const wsMaxPayload = 64 << 10
+
+// wsWriteTimeout bounds each write to a client, so that a client which stops
+// reading doesn't hold its handler's goroutine forever.
+const wsWriteTimeout = 10 * time.Second
+
+const (
+	wsOpText  = 0x1
+	wsOpClose = 0x8
+	wsOpPing  = 0x9
+	wsOpPong  = 0xa
+)
+
+type wsConn struct {
+	conn net.Conn
+	br   *bufio.Reader
+	mu   sync.Mutex // protects writes to conn
+}
+
+// wsUpgrade takes over the connection of a WebSocket handshake request. If
+// it fails, the error has already been reported to the client, or the
+// connection is no longer usable, so the handler must not write a response.
+func wsUpgrade(rw http.ResponseWriter, req *http.Request) (*wsConn, error) {
+	if !strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+		http.Error(rw, "not a websocket request", http.StatusBadRequest)
+		return nil, fmt.Errorf("not a websocket request")
+	}
+	key := req.Header.Get("Sec-WebSocket-Key")
+	if key == "" {
+		http.Error(rw, "missing Sec-WebSocket-Key", http.StatusBadRequest)
+		return nil, fmt.Errorf("missing Sec-WebSocket-Key")
+	}
+	hj, ok := rw.(http.Hijacker)
+	if !ok {
+		http.Error(rw, "connection cannot be hijacked", http.StatusInternalServerError)
+		return nil, fmt.Errorf("connection cannot be hijacked")
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		log.Printf("websocket: %s", err)
+		return nil, err
+	}
+	_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+
+	h := sha1.New()
+	_, _ = io.WriteString(h, key+wsGUID)
+	accept := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	resp := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + accept + "\r\n\r\n"
+	if _, err := conn.Write([]byte(resp)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &wsConn{conn: conn, br: brw.Reader}, nil
+}
+
+func (c *wsConn) writeFrame(op byte, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+		return err
+	}
+	hdr := []byte{0x80 | op}
+	switch n := len(payload); {
+	case n < 126:
+		hdr = append(hdr, byte(n))
+	case n <= 0xffff:
+		hdr = append(hdr, 126, 0, 0)
+		binary.BigEndian.PutUint16(hdr[2:], uint16(n))
+	default:
+		hdr = append(hdr, 127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(hdr[2:], uint64(n))
+	}
+	if _, err := c.conn.Write(hdr); err != nil {
+		return err
+	}
+	_, err := c.conn.Write(payload)
+	return err
+}
+
+func (c *wsConn) writeText(s string) error {
+	return c.writeFrame(wsOpText, []byte(s))
+}
+
+// readMessage returns the next text message from the client, transparently
+// answering pings. It returns io.EOF when the client closes the connection.
+func (c *wsConn) readMessage() (string, error) {
+	for {
+		var hdr [2]byte
+		if _, err := io.ReadFull(c.br, hdr[:]); err != nil {
+			return "", err
+		}
+		op := hdr[0] & 0x0f
+		masked := hdr[1]&0x80 != 0
+		n := uint64(hdr[1] & 0x7f)
+		switch n {
+		case 126:
+			var b [2]byte
+			if _, err := io.ReadFull(c.br, b[:]); err != nil {
+				return "", err
+			}
+			n = uint64(binary.BigEndian.Uint16(b[:]))
+		case 127:
+			var b [8]byte
+			if _, err := io.ReadFull(c.br, b[:]); err != nil {
+				return "", err
+			}
+			n = binary.BigEndian.Uint64(b[:])
+		}
+		if n > wsMaxPayload {
+			return "", fmt.Errorf("websocket message too large: %d bytes", n)
+		}
+		var mask [4]byte
+		if masked {
+			if _, err := io.ReadFull(c.br, mask[:]); err != nil {
+				return "", err
+			}
+		}
+		payload := make([]byte, n)
+		if _, err := io.ReadFull(c.br, payload); err != nil {
+			return "", err
+		}
+		if masked {
+			for i := range payload {
+				payload[i] ^= mask[i%4]
+			}
+		}
+
+		switch op {
+		case wsOpText:
+			return string(payload), nil
+		case wsOpPing:
+			if err := c.writeFrame(wsOpPong, payload); err != nil {
+				return "", err
+			}
+		case wsOpClose:
+			_ = c.writeFrame(wsOpClose, nil)
+			return "", io.EOF
+		}
+	}
+}
+
+func (c *wsConn) close() {
+	_ = c.writeFrame(wsOpClose, nil)
+	c.conn.Close()
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// zombify turns the run into a zombie: its process is stopped as by pause,
+// but its listeners stay open, so that the rest of the cluster can connect
+// to it but never gets a response. To gossip the node looks alive but
+// stuck. Its health port isn't intercepted: the stopped process still holds
+// it, so health checks hang like any other request. If healthLie is set,
+// only roachdemo's own readiness probes (see probeReady) report the node as
+// ready instead of timing out.
+func (r *nodeRun) zombify(healthLie bool) {
+	if r.Cmd == nil || r.Cmd.Process == nil {
+		return
+	}
+
+	r.pause()
+	r.Zombie, r.HealthLie = true, healthLie
+}
+
+// zombieNode turns the node's active run into a zombie, lying about its
+// readiness to roachdemo's probes if the "health" form value is "ok".
+func (c *cluster) zombieNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+	r := t.Active()
+	if r == nil || t.Status() == "Stopped" {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, fmt.Sprintf("node %s is not running", t.Name))
+		return
+	}
+
+	healthLie := req.FormValue("health") == "ok"
+	r.zombify(healthLie)
+	if healthLie {
+		c.events.add(t.Name, "run %d made a zombie, reported ready by roachdemo's probes", r.ID)
+	} else {
+		c.events.add(t.Name, "run %d made a zombie", r.ID)
+	}
+
+	redirect(rw, req)
+}
+
+// reviveNode resumes the node's zombie run.
+func (c *cluster) reviveNode(rw http.ResponseWriter, req *http.Request, args map[string]string) {
+	t := c.findNode(rw, args)
+	if t == nil {
+		return
+	}
+	r := t.Active()
+	if r == nil || t.Status() != "Zombie" {
+		rw.WriteHeader(http.StatusConflict)
+		renderError(rw, fmt.Sprintf("node %s is not a zombie", t.Name))
+		return
+	}
+
+	r.resume()
+	c.events.add(t.Name, "run %d revived", r.ID)
+
+	redirect(rw, req)
+}